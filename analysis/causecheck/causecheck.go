@@ -0,0 +1,360 @@
+// Package causecheck defines an Analyzer that checks the usage of Causer enums.
+//
+// It reports:
+//   - switch statements over Error[T].Cause that do not handle every Cause of T
+//   - Causer enum constants whose value is zero, which is reserved for Ok
+//   - calls to errors.New() with a zero Cause, which is Ok and not an error
+//
+// Missing switch cases come with a suggested fix that inserts them.
+package causecheck
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const errorsPath = "github.com/wspowell/errors"
+
+const doc = `check usage of Causer enums
+
+Reports switch statements over Error[T].Cause that do not handle every Cause,
+Causer enum constants with the value zero (reserved for Ok), and errors.New()
+called with a zero Cause.`
+
+// Analyzer checks the usage of Causer enums.
+//
+//nolint:gochecknoglobals // reason: analyzers are declared as globals by convention
+var Analyzer = &analysis.Analyzer{
+	Name:     "causecheck",
+	Doc:      doc,
+	URL:      "https://pkg.go.dev/github.com/wspowell/errors/analysis/causecheck",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+//nolint:gochecknoinits // reason: analyzer flags are registered during initialization
+func init() {
+	Analyzer.Flags.BoolVar(&defaultSignifiesExhaustive, "default-signifies-exhaustive", false,
+		"a default case makes a switch over Error[T].Cause exhaustive")
+}
+
+//nolint:gochecknoglobals // reason: analyzer flag
+var defaultSignifiesExhaustive bool
+
+func run(pass *analysis.Pass) (any, error) {
+	//nolint:forcetypeassert // reason: required analyzer result
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	checkZeroConsts(pass)
+
+	nodeFilter := []ast.Node{
+		(*ast.File)(nil),
+		(*ast.SwitchStmt)(nil),
+		(*ast.CallExpr)(nil),
+	}
+
+	var file *ast.File
+	inspect.Preorder(nodeFilter, func(node ast.Node) {
+		switch node := node.(type) {
+		case *ast.File:
+			file = node
+		case *ast.SwitchStmt:
+			checkSwitch(pass, file, node)
+		case *ast.CallExpr:
+			checkNew(pass, node)
+		}
+	})
+
+	return nil, nil //nolint:nilnil // reason: analyzer has no result
+}
+
+// causeType returns the Causer type of an Error[T].Cause selector expression.
+func causeType(pass *analysis.Pass, expr ast.Expr) (*types.Named, bool) {
+	selector, ok := ast.Unparen(expr).(*ast.SelectorExpr)
+	if !ok || selector.Sel.Name != "Cause" {
+		return nil, false
+	}
+
+	selection, ok := pass.TypesInfo.Selections[selector]
+	if !ok || selection.Kind() != types.FieldVal {
+		return nil, false
+	}
+
+	field := selection.Obj()
+	if field.Pkg() == nil || field.Pkg().Path() != errorsPath {
+		return nil, false
+	}
+
+	named, ok := types.Unalias(field.Type()).(*types.Named)
+
+	return named, ok
+}
+
+// isErrorsFunc returns true if the call is to the named function in the errors package.
+func isErrorsFunc(pass *analysis.Pass, call *ast.CallExpr, name string) bool {
+	fun := ast.Unparen(call.Fun)
+	if index, ok := fun.(*ast.IndexExpr); ok {
+		fun = index.X
+	}
+
+	var ident *ast.Ident
+	switch fun := fun.(type) {
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	case *ast.Ident:
+		ident = fun
+	default:
+		return false
+	}
+
+	fn, ok := pass.TypesInfo.Uses[ident].(*types.Func)
+
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == errorsPath && fn.Name() == name
+}
+
+// isCauser returns true if the type satisfies errors.Causer.
+func isCauser(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok {
+		return false
+	}
+
+	switch basic.Kind() {
+	case types.Uint, types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+		return true
+	}
+
+	return false
+}
+
+// causerTypes of the package are named unsigned integer types that either follow the <Name>Error naming
+// convention or are used as the type argument of an errors function or type.
+func causerTypes(pass *analysis.Pass) map[*types.TypeName]bool {
+	causers := map[*types.TypeName]bool{}
+
+	scope := pass.Pkg.Scope()
+	for _, name := range scope.Names() {
+		typeName, ok := scope.Lookup(name).(*types.TypeName)
+		if ok && !typeName.IsAlias() && strings.HasSuffix(name, "Error") && isCauser(typeName.Type()) {
+			causers[typeName] = true
+		}
+	}
+
+	for ident, instance := range pass.TypesInfo.Instances {
+		obj := pass.TypesInfo.Uses[ident]
+		if obj == nil || obj.Pkg() == nil || obj.Pkg().Path() != errorsPath {
+			continue
+		}
+
+		for index := 0; index < instance.TypeArgs.Len(); index++ {
+			named, ok := types.Unalias(instance.TypeArgs.At(index)).(*types.Named)
+			if ok && named.Obj().Pkg() == pass.Pkg && isCauser(named) {
+				causers[named.Obj()] = true
+			}
+		}
+	}
+
+	return causers
+}
+
+// checkZeroConsts reports constants of Causer types in this package with the value zero.
+func checkZeroConsts(pass *analysis.Pass) {
+	causers := causerTypes(pass)
+	if len(causers) == 0 {
+		return
+	}
+
+	scope := pass.Pkg.Scope()
+	for _, name := range scope.Names() {
+		cnst, ok := scope.Lookup(name).(*types.Const)
+		if !ok {
+			continue
+		}
+
+		named, ok := types.Unalias(cnst.Type()).(*types.Named)
+		if !ok || !causers[named.Obj()] {
+			continue
+		}
+
+		if value, exact := constant.Uint64Val(cnst.Val()); exact && value == 0 {
+			pass.Reportf(cnst.Pos(), "%s is zero, which is reserved for Ok; Causer enums must start at 1: %s(iota + 1)", cnst.Name(), named.Obj().Name())
+		}
+	}
+}
+
+// checkNew reports errors.New() called with a zero Cause.
+func checkNew(pass *analysis.Pass, call *ast.CallExpr) {
+	if len(call.Args) != 1 || !isErrorsFunc(pass, call, "New") {
+		return
+	}
+
+	value := pass.TypesInfo.Types[call.Args[0]].Value
+	if value == nil {
+		return
+	}
+
+	if code, exact := constant.Uint64Val(value); exact && code == 0 {
+		pass.Reportf(call.Pos(), "errors.New() called with zero, which is Ok; use errors.Ok() instead")
+	}
+}
+
+// checkSwitch reports switch statements over Error[T].Cause missing causes of T.
+func checkSwitch(pass *analysis.Pass, file *ast.File, stmt *ast.SwitchStmt) {
+	if stmt.Tag == nil {
+		return
+	}
+
+	named, ok := causeType(pass, stmt.Tag)
+	if !ok {
+		return
+	}
+
+	handled := map[uint64]bool{}
+	hasDefault := false
+
+	for _, clause := range stmt.Body.List {
+		caseClause, ok := clause.(*ast.CaseClause)
+		if !ok {
+			continue
+		}
+
+		if caseClause.List == nil {
+			hasDefault = true
+		}
+
+		for _, expr := range caseClause.List {
+			if value := pass.TypesInfo.Types[expr].Value; value != nil {
+				if code, exact := constant.Uint64Val(value); exact {
+					handled[code] = true
+				}
+			}
+		}
+	}
+
+	if hasDefault && defaultSignifiesExhaustive {
+		return
+	}
+
+	var missing []*types.Const
+	for _, cnst := range enumConsts(pass, named) {
+		code, _ := constant.Uint64Val(cnst.Val())
+		if !handled[code] {
+			handled[code] = true
+			missing = append(missing, cnst)
+		}
+	}
+
+	if len(missing) == 0 {
+		return
+	}
+
+	names := make([]string, len(missing))
+	for index, cnst := range missing {
+		names[index] = qualifiedName(pass, file, cnst)
+	}
+
+	pass.Report(analysis.Diagnostic{
+		Pos:     stmt.Pos(),
+		End:     stmt.Body.Lbrace,
+		Message: "missing cases in switch of " + named.Obj().Name() + ": " + strings.Join(names, ", "),
+		SuggestedFixes: []analysis.SuggestedFix{
+			missingCasesFix(pass, stmt, names),
+		},
+	})
+}
+
+// enumConsts are the non-zero constants of the Causer type, sorted by value.
+//
+// Constants declared in another package must be exported.
+func enumConsts(pass *analysis.Pass, named *types.Named) []*types.Const {
+	obj := named.Obj()
+	if obj.Pkg() == nil {
+		return nil
+	}
+
+	var consts []*types.Const
+
+	scope := obj.Pkg().Scope()
+	for _, name := range scope.Names() {
+		cnst, ok := scope.Lookup(name).(*types.Const)
+		if !ok || !types.Identical(cnst.Type(), named) {
+			continue
+		}
+
+		if obj.Pkg() != pass.Pkg && !cnst.Exported() {
+			continue
+		}
+
+		if code, exact := constant.Uint64Val(cnst.Val()); !exact || code == 0 {
+			continue
+		}
+
+		consts = append(consts, cnst)
+	}
+
+	sort.SliceStable(consts, func(i int, j int) bool {
+		iCode, _ := constant.Uint64Val(consts[i].Val())
+		jCode, _ := constant.Uint64Val(consts[j].Val())
+
+		return iCode < jCode || (iCode == jCode && consts[i].Pos() < consts[j].Pos())
+	})
+
+	return consts
+}
+
+// qualifiedName of the constant as it would be referenced in the file.
+func qualifiedName(pass *analysis.Pass, file *ast.File, cnst *types.Const) string {
+	if cnst.Pkg() == pass.Pkg {
+		return cnst.Name()
+	}
+
+	for _, spec := range file.Imports {
+		path := strings.Trim(spec.Path.Value, `"`)
+		if path != cnst.Pkg().Path() {
+			continue
+		}
+
+		if spec.Name != nil {
+			return spec.Name.Name + "." + cnst.Name()
+		}
+
+		break
+	}
+
+	return cnst.Pkg().Name() + "." + cnst.Name()
+}
+
+// missingCasesFix inserts a case for the missing causes before the default case, or at the end of the switch.
+func missingCasesFix(pass *analysis.Pass, stmt *ast.SwitchStmt, names []string) analysis.SuggestedFix {
+	pos := stmt.Body.Rbrace
+	for _, clause := range stmt.Body.List {
+		if caseClause, ok := clause.(*ast.CaseClause); ok && caseClause.List == nil {
+			pos = caseClause.Pos()
+
+			break
+		}
+	}
+
+	// Switch statements are assumed to be gofmt indented with tabs.
+	indent := strings.Repeat("\t", pass.Fset.Position(stmt.Pos()).Column-1)
+
+	newText := "case " + strings.Join(names, ", ") + ":\n" +
+		indent + "\t// TODO: handle cause\n" +
+		indent
+
+	return analysis.SuggestedFix{
+		Message: "Add missing cases",
+		TextEdits: []analysis.TextEdit{{
+			Pos:     pos,
+			End:     pos,
+			NewText: []byte(newText),
+		}},
+	}
+}
@@ -0,0 +1,21 @@
+// Command causecheck runs the causecheck analyzer.
+//
+// Usage:
+//
+//	go run github.com/wspowell/errors/analysis/causecheck/cmd/causecheck ./...
+//
+// Or as a vet tool:
+//
+//	go install github.com/wspowell/errors/analysis/causecheck/cmd/causecheck
+//	go vet -vettool=$(which causecheck) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/wspowell/errors/analysis/causecheck"
+)
+
+func main() {
+	singlechecker.Main(causecheck.Analyzer)
+}
@@ -0,0 +1,72 @@
+package a
+
+import (
+	"github.com/wspowell/errors"
+
+	remote "b"
+)
+
+type ExampleError uint
+
+const (
+	ExampleErrorOk = ExampleError(iota) // want `ExampleErrorOk is zero, which is reserved for Ok; Causer enums must start at 1: ExampleError\(iota \+ 1\)`
+	ExampleErrorNotFound
+	ExampleErrorInternalFailure
+)
+
+type GoodError uint
+
+const (
+	GoodErrorNotFound = GoodError(iota + 1)
+	GoodErrorInternalFailure
+)
+
+type SmallError uint8
+
+const (
+	SmallErrorZero    = SmallError(0) // want `SmallErrorZero is zero, which is reserved for Ok; Causer enums must start at 1: SmallError\(iota \+ 1\)`
+	SmallErrorFailure = SmallError(1)
+)
+
+type NotCauser uint
+
+const NotCauserZero = NotCauser(0)
+
+func good() errors.Error[GoodError] {
+	return errors.New(GoodErrorNotFound)
+}
+
+func zero() errors.Error[GoodError] {
+	return errors.New[GoodError](0) // want `errors.New\(\) called with zero, which is Ok; use errors.Ok\(\) instead`
+}
+
+func exhaustive() {
+	switch err := good(); err.Cause {
+	case GoodErrorNotFound:
+	case GoodErrorInternalFailure:
+	}
+}
+
+func missing() {
+	switch err := good(); err.Cause { // want `missing cases in switch of GoodError: GoodErrorInternalFailure`
+	case GoodErrorNotFound:
+	}
+}
+
+func missingWithDefault() {
+	switch err := good(); err.Cause { // want `missing cases in switch of GoodError: GoodErrorNotFound, GoodErrorInternalFailure`
+	default:
+	}
+}
+
+func missingRemote() {
+	switch err := remote.Call(); err.Cause { // want `missing cases in switch of RemoteError: remote.RemoteErrorRefused`
+	case remote.RemoteErrorTimeout:
+	}
+}
+
+func notCause() {
+	switch value := GoodErrorNotFound; value {
+	case GoodErrorNotFound:
+	}
+}
@@ -0,0 +1,15 @@
+package b
+
+import "github.com/wspowell/errors"
+
+type RemoteError uint
+
+const (
+	RemoteErrorTimeout = RemoteError(iota + 1)
+	RemoteErrorRefused
+	remoteErrorHidden
+)
+
+func Call() errors.Error[RemoteError] {
+	return errors.New(remoteErrorHidden)
+}
@@ -0,0 +1,21 @@
+// Command resultcheck runs the resultcheck analyzer.
+//
+// Usage:
+//
+//	go run github.com/wspowell/errors/analysis/resultcheck/cmd/resultcheck ./...
+//
+// Or as a vet tool:
+//
+//	go install github.com/wspowell/errors/analysis/resultcheck/cmd/resultcheck
+//	go vet -vettool=$(which resultcheck) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"github.com/wspowell/errors/analysis/resultcheck"
+)
+
+func main() {
+	singlechecker.Main(resultcheck.Analyzer)
+}
@@ -0,0 +1,262 @@
+// Package resultcheck defines an Analyzer that checks the handling of Error and Result values.
+//
+// It reports:
+//   - calls whose errors.Error[T] or result.Result[T, E] return value is discarded
+//   - calls to Result.Value() that are not guarded by a Result.IsOk() check in the same function
+//
+// A Value() call is considered guarded when it is:
+//   - inside the body of an if statement whose condition requires r.IsOk()
+//   - inside the else branch of an if statement whose condition is !r.IsOk()
+//   - on the right hand side of r.IsOk() && ...
+//   - after an if statement, in the same or an enclosing block, whose condition is !r.IsOk() and whose body terminates
+//
+// Reassigning the Result between the check and the call is not detected.
+package resultcheck
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const (
+	errorsPath = "github.com/wspowell/errors"
+	resultPath = "github.com/wspowell/errors/result"
+)
+
+const doc = `check handling of Error and Result values
+
+Reports calls whose errors.Error[T] or result.Result[T, E] return value is
+discarded and calls to Result.Value() that are not guarded by Result.IsOk().`
+
+// Analyzer checks the handling of Error and Result values.
+//
+//nolint:gochecknoglobals // reason: analyzers are declared as globals by convention
+var Analyzer = &analysis.Analyzer{
+	Name:     "resultcheck",
+	Doc:      doc,
+	URL:      "https://pkg.go.dev/github.com/wspowell/errors/analysis/resultcheck",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	//nolint:forcetypeassert // reason: required analyzer result
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	nodeFilter := []ast.Node{
+		(*ast.ExprStmt)(nil),
+		(*ast.CallExpr)(nil),
+	}
+
+	inspect.WithStack(nodeFilter, func(node ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		switch node := node.(type) {
+		case *ast.ExprStmt:
+			checkDiscarded(pass, node)
+		case *ast.CallExpr:
+			checkValue(pass, node, stack)
+		}
+
+		return true
+	})
+
+	return nil, nil //nolint:nilnil // reason: analyzer has no result
+}
+
+// isNamed returns true if the type is the named (generic) type of the package.
+func isNamed(typ types.Type, path string, name string) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Origin().Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == path && obj.Name() == name
+}
+
+func isErrorOrResult(typ types.Type) bool {
+	return isNamed(typ, errorsPath, "Error") || isNamed(typ, resultPath, "Result")
+}
+
+// checkDiscarded reports a call statement whose Error or Result return value is not used.
+func checkDiscarded(pass *analysis.Pass, stmt *ast.ExprStmt) {
+	call, ok := ast.Unparen(stmt.X).(*ast.CallExpr)
+	if !ok {
+		return
+	}
+
+	typ := pass.TypesInfo.TypeOf(call)
+	if typ == nil {
+		return
+	}
+
+	if tuple, ok := typ.(*types.Tuple); ok {
+		for index := 0; index < tuple.Len(); index++ {
+			if isErrorOrResult(tuple.At(index).Type()) {
+				pass.Reportf(call.Pos(), "%s value of %s is discarded", tuple.At(index).Type(), types.ExprString(call.Fun))
+
+				return
+			}
+		}
+
+		return
+	}
+
+	if isErrorOrResult(typ) {
+		pass.Reportf(call.Pos(), "%s value of %s is discarded", typ, types.ExprString(call.Fun))
+	}
+}
+
+// resultMethodCall returns the receiver if the call is to the named Result method.
+func resultMethodCall(pass *analysis.Pass, expr ast.Expr, name string) (ast.Expr, bool) {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return nil, false
+	}
+
+	selector, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok || selector.Sel.Name != name {
+		return nil, false
+	}
+
+	selection, ok := pass.TypesInfo.Selections[selector]
+	if !ok || selection.Kind() != types.MethodVal || !isNamed(selection.Recv(), resultPath, "Result") {
+		return nil, false
+	}
+
+	return selector.X, true
+}
+
+// checkValue reports a Result.Value() call not guarded by Result.IsOk().
+func checkValue(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node) {
+	receiver, ok := resultMethodCall(pass, call, "Value")
+	if !ok {
+		return
+	}
+
+	key := types.ExprString(receiver)
+	if isGuarded(pass, key, stack) {
+		return
+	}
+
+	pass.Reportf(call.Pos(), "%s.Value() called without checking %s.IsOk()", key, key)
+}
+
+// isGuarded returns true if the innermost node of the stack is only reached when the Result is Ok.
+func isGuarded(pass *analysis.Pass, key string, stack []ast.Node) bool {
+	for index := len(stack) - 2; index >= 0; index-- {
+		child := stack[index+1]
+
+		switch parent := stack[index].(type) {
+		case *ast.FuncDecl, *ast.FuncLit:
+			return false
+		case *ast.IfStmt:
+			if child == parent.Body && requiresOk(pass, key, parent.Cond) {
+				return true
+			}
+
+			if child == parent.Else && requiresNotOk(pass, key, parent.Cond) {
+				return true
+			}
+		case *ast.BinaryExpr:
+			if parent.Op == token.LAND && child == parent.Y && requiresOk(pass, key, parent.X) {
+				return true
+			}
+		case *ast.BlockStmt:
+			if earlyReturn(pass, key, parent.List, child) {
+				return true
+			}
+		case *ast.CaseClause:
+			if earlyReturn(pass, key, parent.Body, child) {
+				return true
+			}
+		case *ast.CommClause:
+			if earlyReturn(pass, key, parent.Body, child) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// requiresOk returns true if the condition can only be true when key.IsOk() is true.
+func requiresOk(pass *analysis.Pass, key string, cond ast.Expr) bool {
+	cond = ast.Unparen(cond)
+
+	if binary, ok := cond.(*ast.BinaryExpr); ok && binary.Op == token.LAND {
+		return requiresOk(pass, key, binary.X) || requiresOk(pass, key, binary.Y)
+	}
+
+	receiver, ok := resultMethodCall(pass, cond, "IsOk")
+
+	return ok && types.ExprString(receiver) == key
+}
+
+// requiresNotOk returns true if the condition is true whenever key.IsOk() is false.
+func requiresNotOk(pass *analysis.Pass, key string, cond ast.Expr) bool {
+	cond = ast.Unparen(cond)
+
+	if binary, ok := cond.(*ast.BinaryExpr); ok && binary.Op == token.LOR {
+		return requiresNotOk(pass, key, binary.X) || requiresNotOk(pass, key, binary.Y)
+	}
+
+	unary, ok := cond.(*ast.UnaryExpr)
+	if !ok || unary.Op != token.NOT {
+		return false
+	}
+
+	receiver, ok := resultMethodCall(pass, unary.X, "IsOk")
+
+	return ok && types.ExprString(receiver) == key
+}
+
+// earlyReturn returns true if a statement before child is `if !key.IsOk() { ... }` with a terminating body.
+func earlyReturn(pass *analysis.Pass, key string, list []ast.Stmt, child ast.Node) bool {
+	for _, stmt := range list {
+		if stmt == child {
+			return false
+		}
+
+		ifStmt, ok := stmt.(*ast.IfStmt)
+		if ok && ifStmt.Init == nil && requiresNotOk(pass, key, ifStmt.Cond) && terminates(ifStmt.Body) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// terminates returns true if the block always ends by leaving the enclosing flow.
+func terminates(block *ast.BlockStmt) bool {
+	if len(block.List) == 0 {
+		return false
+	}
+
+	switch last := block.List[len(block.List)-1].(type) {
+	case *ast.ReturnStmt:
+		return true
+	case *ast.BranchStmt:
+		return last.Tok == token.BREAK || last.Tok == token.CONTINUE || last.Tok == token.GOTO
+	case *ast.ExprStmt:
+		call, ok := last.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+
+		ident, ok := call.Fun.(*ast.Ident)
+
+		return ok && ident.Name == "panic"
+	}
+
+	return false
+}
@@ -0,0 +1,15 @@
+package resultcheck_test
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"github.com/wspowell/errors/analysis/resultcheck"
+)
+
+func TestAnalyzer(t *testing.T) {
+	t.Parallel()
+
+	analysistest.Run(t, analysistest.TestData(), resultcheck.Analyzer, "a")
+}
@@ -0,0 +1,136 @@
+package a
+
+import (
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+type ExampleError uint
+
+const (
+	ExampleErrorFailure = ExampleError(iota + 1)
+)
+
+func doThing() errors.Error[ExampleError] {
+	return errors.New(ExampleErrorFailure)
+}
+
+func getThing() result.Result[int, errors.Error[ExampleError]] {
+	return result.Ok[int, errors.Error[ExampleError]](1)
+}
+
+func pair() (int, errors.Error[ExampleError]) {
+	return 0, errors.Ok[ExampleError]()
+}
+
+func discarded() {
+	doThing()  // want `github.com/wspowell/errors.Error\[a.ExampleError\] value of doThing is discarded`
+	getThing() // want `value of getThing is discarded`
+	pair()     // want `value of pair is discarded`
+	_ = doThing()
+	defer doThing()
+}
+
+func unchecked() int {
+	res := getThing()
+
+	return res.Value() // want `res.Value\(\) called without checking res.IsOk\(\)`
+}
+
+func uncheckedCall() int {
+	return getThing().Value() // want `getThing\(\).Value\(\) called without checking getThing\(\).IsOk\(\)`
+}
+
+func checkedIf() int {
+	res := getThing()
+	if res.IsOk() {
+		return res.Value()
+	}
+
+	return 0
+}
+
+func checkedAnd() bool {
+	res := getThing()
+
+	return res.IsOk() && res.Value() > 0
+}
+
+func checkedElse() int {
+	res := getThing()
+	if !res.IsOk() {
+		return 0
+	} else {
+		return res.Value()
+	}
+}
+
+func checkedEarlyReturn() int {
+	res := getThing()
+	if !res.IsOk() {
+		return 0
+	}
+
+	for i := 0; i < 3; i++ {
+		if i > 1 {
+			return res.Value()
+		}
+	}
+
+	return res.Value()
+}
+
+func checkedEarlyReturnOther() int {
+	res := getThing()
+	other := getThing()
+	if !other.IsOk() {
+		return 0
+	}
+
+	return res.Value() // want `res.Value\(\) called without checking res.IsOk\(\)`
+}
+
+func checkedAfterUse() int {
+	res := getThing()
+	value := res.Value() // want `res.Value\(\) called without checking res.IsOk\(\)`
+
+	if !res.IsOk() {
+		return 0
+	}
+
+	return value
+}
+
+func checkedNoTerminate() int {
+	res := getThing()
+	if !res.IsOk() {
+		println("failed")
+	}
+
+	return res.Value() // want `res.Value\(\) called without checking res.IsOk\(\)`
+}
+
+func checkedClosure() func() int {
+	res := getThing()
+	if !res.IsOk() {
+		return nil
+	}
+
+	return func() int {
+		return res.Value() // want `res.Value\(\) called without checking res.IsOk\(\)`
+	}
+}
+
+func checkedLoop(results []result.Result[int, errors.Error[ExampleError]]) int {
+	total := 0
+
+	for _, res := range results {
+		if !res.IsOk() {
+			continue
+		}
+
+		total += res.Value()
+	}
+
+	return total
+}
@@ -0,0 +1,21 @@
+package errors
+
+type Causer interface {
+	~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uint
+}
+
+type Error[T Causer] struct {
+	Cause T
+}
+
+func New[T Causer](cause T) Error[T] {
+	return Error[T]{Cause: cause}
+}
+
+func Ok[T Causer]() Error[T] {
+	return Error[T]{}
+}
+
+func (self Error[T]) Error() string {
+	return "error"
+}
@@ -0,0 +1,41 @@
+package result
+
+type Error interface {
+	error
+	comparable
+}
+
+type Result[T any, E Error] struct {
+	value T
+	err   E
+}
+
+func Ok[T any, E Error](value T) Result[T, E] {
+	return Result[T, E]{value: value}
+}
+
+func Err[T any, E Error](err E) Result[T, E] {
+	return Result[T, E]{err: err}
+}
+
+func (self Result[T, E]) IsOk() bool {
+	var ok E
+
+	return self.err == ok
+}
+
+func (self Result[T, E]) Error() E {
+	return self.err
+}
+
+func (self Result[T, E]) Value() T {
+	return self.value
+}
+
+func (self Result[T, E]) ValueOr(value T) T {
+	if self.IsOk() {
+		return self.value
+	}
+
+	return value
+}
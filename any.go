@@ -0,0 +1,147 @@
+package errors
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// AnyError is an Error whose Cause type has been erased.
+//
+// Errors of different Cause types can be stored together in the same slice, map, or channel
+// without boxing them as error, which would lose the Cause. An AnyError holds the Cause type
+// and numeric code, so it does not allocate and is comparable.
+//
+// The zero value is Ok.
+//
+// See: Error.Any(), AsCause()
+type AnyError struct {
+	causeType reflect.Type
+	code      uint64
+}
+
+// Any erases the Cause type of the Error.
+//
+// The stack trace recorded under the errorsdebug build tag is not kept.
+func (self Error[T]) Any() AnyError {
+	if self.IsOk() {
+		return AnyError{}
+	}
+
+	return AnyError{
+		causeType: reflect.TypeFor[T](),
+		code:      uint64(self.Cause),
+	}
+}
+
+// AsCause of type T.
+//
+// Returns false if the AnyError is Ok or if its Cause is not of type T.
+func AsCause[T Causer](err AnyError) (T, bool) {
+	if err.IsOk() || err.causeType != reflect.TypeFor[T]() {
+		return 0, false
+	}
+
+	return T(err.code), true
+}
+
+// IsOk returns true if this is an Ok AnyError instance.
+func (self AnyError) IsOk() bool {
+	return self.code == 0
+}
+
+// IsErr returns true if this is a non-zero AnyError instance.
+func (self AnyError) IsErr() bool {
+	return self.code != 0
+}
+
+// Type of the Cause, or nil if Ok.
+func (self AnyError) Type() reflect.Type {
+	return self.causeType
+}
+
+// Code is the numeric value of the Cause.
+func (self AnyError) Code() uint64 {
+	return self.code
+}
+
+// Info is the registered metadata of the Cause.
+//
+// See: Register()
+func (self AnyError) Info() (CauseInfo, bool) {
+	if self.IsOk() {
+		return CauseInfo{}, false
+	}
+
+	catalog, ok := catalogOfType(self.causeType)
+	if !ok {
+		return CauseInfo{}, false
+	}
+
+	info, ok := catalog.byCause[self.code]
+
+	return info, ok
+}
+
+// Name of the Cause.
+//
+// Resolved as the registered Code, then String() of the Cause type, otherwise Type(code).
+// Resolving String() requires reflection.
+func (self AnyError) Name() string {
+	if self.IsOk() {
+		return "Ok"
+	}
+
+	if info, ok := self.Info(); ok && info.Code != "" {
+		return info.Code
+	}
+
+	if name, ok := self.stringName(); ok {
+		return name
+	}
+
+	return self.typeCode()
+}
+
+// Error string representation.
+//
+// Satisfies golang's Error() string interface.
+//
+// Matches the Error() string of the original Error, except that Ok is always "Ok".
+func (self AnyError) Error() string {
+	if self.IsOk() {
+		return "Ok"
+	}
+
+	if name, ok := self.stringName(); ok {
+		return name
+	}
+
+	if info, ok := self.Info(); ok {
+		if info.Message != "" {
+			return info.Message
+		}
+
+		if info.Code != "" {
+			return info.Code
+		}
+	}
+
+	return self.typeCode()
+}
+
+// stringName calls String() on the Cause using reflection.
+func (self AnyError) stringName() (string, bool) {
+	value := reflect.New(self.causeType).Elem()
+	value.SetUint(self.code)
+
+	if asStringer, ok := value.Interface().(fmt.Stringer); ok {
+		return asStringer.String(), true
+	}
+
+	return "", false
+}
+
+func (self AnyError) typeCode() string {
+	return self.causeType.String() + "(" + strconv.FormatUint(self.code, 10) + ")"
+}
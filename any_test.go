@@ -0,0 +1,103 @@
+package errors_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+)
+
+func TestAnyError(t *testing.T) {
+	t.Parallel()
+
+	errs := []errors.AnyError{
+		errors.New(TestErrorMyBad).Any(),
+		errors.New(RegisteredErrorNotFound).Any(),
+		errors.New(NoStringerErrorMyBad).Any(),
+		errors.New(Uint8Error(7)).Any(),
+	}
+
+	cause, ok := errors.AsCause[TestError](errs[0])
+	assert.True(t, ok)
+	assert.Equal(t, TestErrorMyBad, cause)
+
+	_, ok = errors.AsCause[NoStringerError](errs[0])
+	assert.False(t, ok)
+
+	uint8Cause, ok := errors.AsCause[Uint8Error](errs[3])
+	assert.True(t, ok)
+	assert.Equal(t, Uint8Error(7), uint8Cause)
+
+	for _, err := range errs {
+		assert.True(t, err.IsErr())
+		assert.False(t, err.IsOk())
+	}
+
+	assert.Equal(t, reflect.TypeFor[RegisteredError](), errs[1].Type())
+	assert.Equal(t, uint64(1), errs[1].Code())
+}
+
+func TestAnyErrorOk(t *testing.T) {
+	t.Parallel()
+
+	err := errors.Ok[TestError]().Any()
+	assert.Equal(t, errors.AnyError{}, err)
+	assert.True(t, err.IsOk())
+	assert.False(t, err.IsErr())
+	assert.Nil(t, err.Type())
+	assert.Equal(t, "Ok", err.Name())
+	assert.Equal(t, "Ok", err.Error())
+
+	_, ok := errors.AsCause[TestError](err)
+	assert.False(t, ok)
+}
+
+func TestAnyErrorName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "MyBad", errors.New(TestErrorMyBad).Any().Name())
+	assert.Equal(t, "not_found", errors.New(RegisteredErrorNotFound).Any().Name())
+	assert.Equal(t, "errors_test.RegisteredError(3)", errors.New(RegisteredErrorUnregistered).Any().Name())
+	assert.Equal(t, "errors_test.NoStringerError(1)", errors.New(NoStringerErrorMyBad).Any().Name())
+}
+
+func TestAnyErrorError(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "MyBad", errors.New(TestErrorMyBad).Any().Error())
+	assert.Equal(t, "Resource not found", errors.New(RegisteredErrorNotFound).Any().Error())
+	assert.Equal(t, "timeout", errors.New(RegisteredErrorTimeout).Any().Error())
+	assert.Equal(t, "errors_test.NoStringerError(1)", errors.New(NoStringerErrorMyBad).Any().Error())
+
+	info, ok := errors.New(RegisteredErrorTimeout).Any().Info()
+	assert.True(t, ok)
+	assert.True(t, info.Retryable)
+}
+
+func TestAnyErrorMapKey(t *testing.T) {
+	t.Parallel()
+
+	counts := map[errors.AnyError]int{}
+	counts[errors.New(TestErrorMyBad).Any()]++
+	counts[errors.New(TestErrorMyBad).Any()]++
+	counts[errors.New(NoStringerErrorMyBad).Any()]++
+
+	assert.Equal(t, 2, counts[errors.New(TestErrorMyBad).Any()])
+	assert.Equal(t, 1, counts[errors.New(NoStringerErrorMyBad).Any()])
+}
+
+//nolint:paralleltest // reason: AllocsPerRun cannot be run in parallel
+func TestAnyErrorAllocs(t *testing.T) {
+	errs := make(chan errors.AnyError, 1)
+	err := errors.New(NoStringerError(1000))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		errs <- err.Any()
+		if _, ok := errors.AsCause[NoStringerError](<-errs); !ok {
+			t.Fail()
+		}
+	})
+	assert.Zero(t, allocs)
+}
@@ -0,0 +1,48 @@
+package errors
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// AppendCause appends the name of the Cause to dst and returns the extended buffer.
+//
+// The name is the same as Error(): String(), if implemented, then the Message (or Code) of
+// the registered CauseInfo, otherwise Type(code). Unlike Error(), the Type(code) fallback
+// does not allocate.
+//
+// Checking for String() boxes the Cause, which only avoids allocating for values below 256.
+func AppendCause[T Causer](dst []byte, cause T) []byte {
+	if asStringer, ok := any(cause).(fmt.Stringer); ok {
+		return append(dst, asStringer.String()...)
+	}
+
+	if info, ok := Lookup(cause); ok {
+		if info.Message != "" {
+			return append(dst, info.Message...)
+		}
+
+		if info.Code != "" {
+			return append(dst, info.Code...)
+		}
+	}
+
+	dst = append(dst, reflect.TypeFor[T]().String()...)
+	dst = append(dst, '(')
+
+	if cause == 0 {
+		dst = append(dst, "Ok"...)
+	} else {
+		dst = strconv.AppendUint(dst, uint64(cause), 10)
+	}
+
+	return append(dst, ')')
+}
+
+// AppendError appends the Error() string to dst and returns the extended buffer.
+//
+// Use with a reused buffer on hot logging paths to avoid allocating a string per Error.
+func (self Error[T]) AppendError(dst []byte) []byte {
+	return AppendCause(dst, self.Cause)
+}
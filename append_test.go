@@ -0,0 +1,44 @@
+package errors_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+)
+
+func TestAppendCause(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "MyBad", string(errors.AppendCause(nil, TestErrorMyBad)))
+	assert.Equal(t, "Ok", string(errors.AppendCause(nil, TestError(0))))
+	assert.Equal(t, "Resource not found", string(errors.AppendCause(nil, RegisteredErrorNotFound)))
+	assert.Equal(t, "errors_test.NoStringerError(1)", string(errors.AppendCause(nil, NoStringerError(1))))
+	assert.Equal(t, "errors_test.NoStringerError(Ok)", string(errors.AppendCause(nil, NoStringerError(0))))
+}
+
+func TestAppendError(t *testing.T) {
+	t.Parallel()
+
+	for _, err := range []errors.Error[NoStringerError]{errors.Ok[NoStringerError](), errors.New(NoStringerError(1)), errors.New(NoStringerError(300))} {
+		assert.Equal(t, err.Error(), string(err.AppendError(nil)))
+	}
+
+	assert.Equal(t, "error: MyBad", string(errors.New(TestErrorMyBad).AppendError([]byte("error: "))))
+}
+
+//nolint:paralleltest // reason: AllocsPerRun cannot be run in parallel
+func TestAppendErrorAllocs(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	stringerErr := errors.New(TestErrorMyBad)
+	fallbackErr := errors.New(NoStringerError(1))
+	registeredErr := errors.New(RegisteredErrorNotFound)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = stringerErr.AppendError(buf[:0])
+		buf = fallbackErr.AppendError(buf[:0])
+		buf = registeredErr.AppendError(buf[:0])
+	})
+	assert.Zero(t, allocs)
+}
@@ -0,0 +1,73 @@
+package errors
+
+import (
+	goerrors "errors"
+)
+
+// Rule that classifies a golang error into a Cause.
+//
+// See: MatchIs(), MatchAs(), MatchFunc()
+type Rule[T Causer] struct {
+	match func(err error) bool
+	cause T
+}
+
+// MatchIs classifies errors matching sentinel via golang's errors.Is() as cause.
+func MatchIs[T Causer](sentinel error, cause T) Rule[T] {
+	return Rule[T]{
+		match: func(err error) bool {
+			return goerrors.Is(err, sentinel)
+		},
+		cause: cause,
+	}
+}
+
+// MatchAs classifies errors containing an E via golang's errors.As() as cause.
+func MatchAs[E error, T Causer](cause T) Rule[T] {
+	return Rule[T]{
+		match: func(err error) bool {
+			var target E
+
+			return goerrors.As(err, &target)
+		},
+		cause: cause,
+	}
+}
+
+// MatchFunc classifies errors satisfying predicate as cause.
+func MatchFunc[T Causer](predicate func(err error) bool, cause T) Rule[T] {
+	return Rule[T]{
+		match: predicate,
+		cause: cause,
+	}
+}
+
+// From lifts a golang error into an Error[T].
+//
+// A nil err is Ok. If err already contains an Error[T], its Cause is kept.
+// Otherwise, rules are evaluated in order and the first match wins.
+// If no rule matches, the Error has the fallback Cause.
+func From[T Causer](err error, fallback T, rules ...Rule[T]) Error[T] {
+	if err == nil {
+		return Ok[T]()
+	}
+
+	if cause, ok := CauseOf[T](err); ok {
+		return New(cause)
+	}
+
+	for _, rule := range rules {
+		if rule.match(err) {
+			return New(rule.cause)
+		}
+	}
+
+	return New(fallback)
+}
+
+// Classify creates a reusable classifier that calls From() with the given fallback and rules.
+func Classify[T Causer](fallback T, rules ...Rule[T]) func(err error) Error[T] {
+	return func(err error) Error[T] {
+		return From(err, fallback, rules...)
+	}
+}
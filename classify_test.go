@@ -0,0 +1,78 @@
+package errors_test
+
+import (
+	goerrors "errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+)
+
+func TestFromNil(t *testing.T) {
+	err := errors.From(nil, TestErrorInternalFailure)
+	assert.True(t, err.IsOk())
+}
+
+func TestFromFallback(t *testing.T) {
+	//nolint:goerr113 // reason: error created for test
+	err := errors.From(goerrors.New("unknown"), TestErrorInternalFailure,
+		errors.MatchIs(io.EOF, TestErrorMyBad),
+	)
+	assert.Equal(t, errors.New(TestErrorInternalFailure), err)
+}
+
+func TestFromMatchIs(t *testing.T) {
+	err := errors.From(fmt.Errorf("read: %w", io.EOF), TestErrorInternalFailure,
+		errors.MatchIs(io.EOF, TestErrorMyBad),
+	)
+	assert.Equal(t, errors.New(TestErrorMyBad), err)
+}
+
+func TestFromMatchAs(t *testing.T) {
+	_, openErr := os.Open("does-not-exist")
+
+	err := errors.From(openErr, TestErrorInternalFailure,
+		errors.MatchIs(io.EOF, TestErrorInternalFailure),
+		errors.MatchAs[*fs.PathError](TestErrorMyBad),
+	)
+	assert.Equal(t, errors.New(TestErrorMyBad), err)
+}
+
+func TestFromMatchFunc(t *testing.T) {
+	err := errors.From(io.ErrUnexpectedEOF, TestErrorInternalFailure,
+		errors.MatchFunc(func(err error) bool {
+			return err.Error() == "unexpected EOF"
+		}, TestErrorMyBad),
+	)
+	assert.Equal(t, errors.New(TestErrorMyBad), err)
+}
+
+func TestFromKeepsCause(t *testing.T) {
+	err := errors.From(fmt.Errorf("wrapped: %w", errors.New(TestErrorMyBad)), TestErrorInternalFailure,
+		errors.MatchFunc(func(err error) bool { return true }, TestErrorInternalFailure),
+	)
+	assert.Equal(t, errors.New(TestErrorMyBad), err)
+}
+
+func TestFromFirstRuleWins(t *testing.T) {
+	err := errors.From(io.EOF, TestErrorInternalFailure,
+		errors.MatchIs(io.EOF, TestErrorMyBad),
+		errors.MatchIs(io.EOF, TestErrorInternalFailure),
+	)
+	assert.Equal(t, errors.New(TestErrorMyBad), err)
+}
+
+func TestClassify(t *testing.T) {
+	classify := errors.Classify(TestErrorInternalFailure,
+		errors.MatchIs(io.EOF, TestErrorMyBad),
+	)
+
+	assert.True(t, classify(nil).IsOk())
+	assert.Equal(t, errors.New(TestErrorMyBad), classify(io.EOF))
+	assert.Equal(t, errors.New(TestErrorInternalFailure), classify(io.ErrClosedPipe))
+}
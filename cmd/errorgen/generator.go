@@ -0,0 +1,427 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// generatedHeader starts every file written by errorgen.
+const generatedHeader = "// Code generated by errorgen; DO NOT EDIT."
+
+// causer enum found in the package.
+type causer struct {
+	Name   string
+	Values []causeValue
+	// Declared methods (and the Parse function) that already exist outside of the output file.
+	Declared map[string]bool
+}
+
+// causeValue is a single constant of a causer enum.
+type causeValue struct {
+	Ident string
+	Name  string
+	Value uint64
+}
+
+// packageName of the package in dir, without type checking.
+func packageName(dir string) (string, error) {
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName, Dir: dir}, ".")
+	if err != nil {
+		return "", fmt.Errorf("failed to load package: %w", err)
+	}
+
+	if len(pkgs) != 1 {
+		return "", fmt.Errorf("expected one package in %s, found %d", dir, len(pkgs))
+	}
+
+	return pkgs[0].Name, nil
+}
+
+// loadPackage in dir.
+//
+// A previous output of errorgen at ignore is loaded as an empty file. Otherwise, its methods
+// would be seen as declared by hand or, if they now conflict, fail type checking.
+func loadPackage(dir string, ignore string) (*packages.Package, error) {
+	config := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedSyntax | packages.NeedTypesInfo,
+		Dir:  dir,
+	}
+
+	if ignore != "" {
+		overlay, err := emptyOverlay(ignore)
+		if err != nil {
+			return nil, err
+		}
+
+		config.Overlay = overlay
+	}
+
+	pkgs, err := packages.Load(config, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to load package: %w", err)
+	}
+
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected one package in %s, found %d", dir, len(pkgs))
+	}
+
+	pkg := pkgs[0]
+	if len(pkg.Errors) != 0 {
+		return nil, fmt.Errorf("failed to load package: %s", pkg.Errors[0])
+	}
+
+	return pkg, nil
+}
+
+// emptyOverlay replaces a previously generated file with only its package clause.
+//
+// Fails if the file exists but was not generated by errorgen, since it would be overwritten.
+func emptyOverlay(path string) (map[string][]byte, error) {
+	src, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	if !bytes.HasPrefix(src, []byte(generatedHeader)) {
+		return nil, fmt.Errorf("refusing to overwrite %s: not generated by errorgen", path)
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), path, src, parser.PackageClauseOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", path, err)
+	}
+
+	return map[string][]byte{
+		abs: []byte("package " + file.Name.Name + "\n"),
+	}, nil
+}
+
+// isCauser returns true if the underlying type satisfies errors.Causer.
+func isCauser(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok {
+		return false
+	}
+
+	switch basic.Kind() {
+	case types.Uint, types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+		return true
+	}
+
+	return false
+}
+
+// findCausers in the package, optionally limited to the given type names.
+//
+// Types without any non-zero constants are ignored.
+func findCausers(pkg *packages.Package, typeNames []string) []causer {
+	comments := constComments(pkg.Syntax)
+	scope := pkg.Types.Scope()
+
+	var causers []causer
+
+	for _, name := range scope.Names() {
+		typeName, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || typeName.IsAlias() || !isCauser(typeName.Type()) {
+			continue
+		}
+
+		if len(typeNames) != 0 && !contains(typeNames, name) {
+			continue
+		}
+
+		values := causeValues(scope, typeName, comments)
+		if len(values) == 0 {
+			continue
+		}
+
+		causers = append(causers, causer{
+			Name:     name,
+			Values:   values,
+			Declared: declaredMethods(scope, typeName),
+		})
+	}
+
+	return causers
+}
+
+// declaredMethods of the type that errorgen would otherwise generate, or nil if there are none.
+func declaredMethods(scope *types.Scope, typeName *types.TypeName) map[string]bool {
+	var declared map[string]bool
+
+	methods := types.NewMethodSet(typeName.Type())
+	for _, method := range []string{"String", "GoString", "Values", "IsValid"} {
+		if methods.Lookup(typeName.Pkg(), method) != nil {
+			if declared == nil {
+				declared = map[string]bool{}
+			}
+
+			declared[method] = true
+		}
+	}
+
+	if scope.Lookup("Parse"+typeName.Name()) != nil {
+		if declared == nil {
+			declared = map[string]bool{}
+		}
+
+		declared["Parse"] = true
+	}
+
+	return declared
+}
+
+func causeValues(scope *types.Scope, typeName *types.TypeName, comments map[token.Pos]string) []causeValue {
+	var values []causeValue
+
+	seen := map[uint64]bool{}
+
+	consts := constsOf(scope, typeName)
+	for _, cnst := range consts {
+		value, exact := constant.Uint64Val(cnst.Val())
+		if !exact || value == 0 || seen[value] {
+			continue
+		}
+
+		seen[value] = true
+
+		name := comments[cnst.Pos()]
+		if name == "" {
+			name = strings.TrimPrefix(cnst.Name(), typeName.Name())
+		}
+
+		values = append(values, causeValue{
+			Ident: cnst.Name(),
+			Name:  name,
+			Value: value,
+		})
+	}
+
+	sort.SliceStable(values, func(i int, j int) bool {
+		return values[i].Value < values[j].Value
+	})
+
+	return values
+}
+
+// constsOf returns all constants of the given type in declaration order.
+func constsOf(scope *types.Scope, typeName *types.TypeName) []*types.Const {
+	var consts []*types.Const
+
+	for _, name := range scope.Names() {
+		cnst, ok := scope.Lookup(name).(*types.Const)
+		if ok && types.Identical(cnst.Type(), typeName.Type()) {
+			consts = append(consts, cnst)
+		}
+	}
+
+	sort.SliceStable(consts, func(i int, j int) bool {
+		return consts[i].Pos() < consts[j].Pos()
+	})
+
+	return consts
+}
+
+// constComments maps each constant identifier position to the name given by its comments.
+func constComments(files []*ast.File) map[token.Pos]string {
+	comments := map[token.Pos]string{}
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				valueSpec, ok := spec.(*ast.ValueSpec)
+				if !ok {
+					continue
+				}
+
+				doc := valueSpec.Doc
+				if doc == nil && !genDecl.Lparen.IsValid() {
+					doc = genDecl.Doc
+				}
+
+				name := commentName(valueSpec.Comment)
+				if name == "" {
+					name = commentName(doc)
+				}
+
+				for _, ident := range valueSpec.Names {
+					comments[ident.Pos()] = name
+				}
+			}
+		}
+	}
+
+	return comments
+}
+
+// commentName is the first line of the comment without a trailing period.
+func commentName(group *ast.CommentGroup) string {
+	if group == nil {
+		return ""
+	}
+
+	line, _, _ := strings.Cut(group.Text(), "\n")
+
+	return strings.TrimSuffix(strings.TrimSpace(line), ".")
+}
+
+func contains(values []string, value string) bool {
+	for _, each := range values {
+		if each == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// generate the formatted source for all causers.
+//
+// Methods that are already declared are not generated.
+func generate(pkgName string, causers []causer) ([]byte, error) {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "%s\n\n", generatedHeader)
+	fmt.Fprintf(&buf, "package %s\n", pkgName)
+
+	for _, each := range causers {
+		if !each.Declared["String"] || !each.Declared["GoString"] {
+			fmt.Fprintf(&buf, "\nimport \"strconv\"\n")
+
+			break
+		}
+	}
+
+	for _, each := range causers {
+		generateCauser(&buf, pkgName, each)
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated source: %w", err)
+	}
+
+	return src, nil
+}
+
+func generateCauser(buf *bytes.Buffer, pkgName string, each causer) {
+	if !each.Declared["String"] {
+		generateString(buf, each)
+	}
+
+	if !each.Declared["GoString"] {
+		generateGoString(buf, pkgName, each)
+	}
+
+	if !each.Declared["Values"] {
+		generateValues(buf, each)
+	}
+
+	if !each.Declared["IsValid"] {
+		generateIsValid(buf, each)
+	}
+
+	if !each.Declared["Parse"] {
+		generateParse(buf, each)
+	}
+}
+
+func generateString(buf *bytes.Buffer, each causer) {
+	fmt.Fprintf(buf, "\n// String name of the %s.\n", each.Name)
+	fmt.Fprintf(buf, "func (self %s) String() string {\n", each.Name)
+	fmt.Fprintf(buf, "switch self {\n")
+	for _, value := range each.Values {
+		fmt.Fprintf(buf, "case %s:\nreturn %q\n", value.Ident, value.Name)
+	}
+	fmt.Fprintf(buf, "case 0:\nreturn \"Ok\"\n")
+	fmt.Fprintf(buf, "}\n\n")
+	fmt.Fprintf(buf, "return \"%s(\" + strconv.FormatUint(uint64(self), 10) + \")\"\n", each.Name)
+	fmt.Fprintf(buf, "}\n")
+}
+
+func generateGoString(buf *bytes.Buffer, pkgName string, each causer) {
+	fmt.Fprintf(buf, "\n// GoString of the %s in Go syntax.\n", each.Name)
+	fmt.Fprintf(buf, "func (self %s) GoString() string {\n", each.Name)
+	fmt.Fprintf(buf, "switch self {\n")
+	for _, value := range each.Values {
+		fmt.Fprintf(buf, "case %s:\nreturn %q\n", value.Ident, pkgName+"."+value.Ident)
+	}
+	fmt.Fprintf(buf, "}\n\n")
+	fmt.Fprintf(buf, "return \"%s.%s(\" + strconv.FormatUint(uint64(self), 10) + \")\"\n", pkgName, each.Name)
+	fmt.Fprintf(buf, "}\n")
+}
+
+func generateValues(buf *bytes.Buffer, each causer) {
+	fmt.Fprintf(buf, "\n// Values of all %s causes.\n", each.Name)
+	fmt.Fprintf(buf, "func (self %s) Values() []%s {\n", each.Name, each.Name)
+	fmt.Fprintf(buf, "return []%s{\n", each.Name)
+	for _, value := range each.Values {
+		fmt.Fprintf(buf, "%s,\n", value.Ident)
+	}
+	fmt.Fprintf(buf, "}\n}\n")
+}
+
+func generateIsValid(buf *bytes.Buffer, each causer) {
+	fmt.Fprintf(buf, "\n// IsValid returns true if this is a declared %s cause.\n", each.Name)
+	fmt.Fprintf(buf, "func (self %s) IsValid() bool {\n", each.Name)
+	fmt.Fprintf(buf, "switch self {\n")
+	fmt.Fprintf(buf, "case ")
+	for index, value := range each.Values {
+		if index != 0 {
+			fmt.Fprintf(buf, ", ")
+		}
+		fmt.Fprintf(buf, "%s", value.Ident)
+	}
+	fmt.Fprintf(buf, ":\nreturn true\n}\n\nreturn false\n}\n")
+}
+
+// generateParse matches names from the comments, unless String() is declared by hand, in which
+// case the String() of each of the Values() is matched instead.
+func generateParse(buf *bytes.Buffer, each causer) {
+	fmt.Fprintf(buf, "\n// Parse%s from its String() name.\n", each.Name)
+	fmt.Fprintf(buf, "func Parse%s(name string) (%s, bool) {\n", each.Name, each.Name)
+
+	if each.Declared["String"] {
+		fmt.Fprintf(buf, "for _, cause := range %s(0).Values() {\n", each.Name)
+		fmt.Fprintf(buf, "if cause.String() == name {\nreturn cause, true\n}\n}\n\nreturn 0, false\n}\n")
+
+		return
+	}
+
+	fmt.Fprintf(buf, "switch name {\n")
+	seen := map[string]bool{}
+	for _, value := range each.Values {
+		if seen[value.Name] {
+			continue
+		}
+		seen[value.Name] = true
+		fmt.Fprintf(buf, "case %q:\nreturn %s, true\n", value.Name, value.Ident)
+	}
+	fmt.Fprintf(buf, "}\n\nreturn 0, false\n}\n")
+}
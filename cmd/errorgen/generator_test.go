@@ -0,0 +1,120 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindCausers(t *testing.T) {
+	t.Parallel()
+
+	pkg, err := loadPackage("testdata/causes", "")
+	require.NoError(t, err)
+
+	causers := findCausers(pkg, nil)
+	assert.Equal(t, []causer{
+		{
+			Name: "ExampleError",
+			Values: []causeValue{
+				{Ident: "ExampleErrorInternalFailure", Name: "Internal failure", Value: 1},
+				{Ident: "ExampleErrorOtherFailure", Name: "Other failure", Value: 2},
+				{Ident: "ExampleErrorNoComment", Name: "NoComment", Value: 3},
+			},
+		},
+		{
+			Name: "SmallError",
+			Values: []causeValue{
+				{Ident: "SmallErrorFailure", Name: "Failure", Value: 1},
+			},
+		},
+	}, causers)
+
+	assert.Empty(t, findCausers(pkg, []string{"NotCauserError"}))
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	pkg, err := loadPackage("testdata/causes", "")
+	require.NoError(t, err)
+
+	src, err := generate(pkg.Name, findCausers(pkg, nil))
+	require.NoError(t, err)
+
+	expected, err := os.ReadFile(filepath.Join("testdata", "causes_errorgen.go.golden"))
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expected), string(src))
+}
+
+func TestLoadPackageIgnoresOutput(t *testing.T) {
+	t.Parallel()
+
+	output := filepath.Join("testdata", "declared", "declared_errorgen.go")
+
+	_, err := loadPackage(filepath.Join("testdata", "declared"), "")
+	require.Error(t, err)
+
+	pkg, err := loadPackage(filepath.Join("testdata", "declared"), output)
+	require.NoError(t, err)
+
+	causers := findCausers(pkg, nil)
+	require.Len(t, causers, 1)
+	assert.Equal(t, map[string]bool{"String": true, "IsValid": true}, causers[0].Declared)
+}
+
+func TestGenerateDeclared(t *testing.T) {
+	t.Parallel()
+
+	pkg, err := loadPackage(filepath.Join("testdata", "declared"), filepath.Join("testdata", "declared", "declared_errorgen.go"))
+	require.NoError(t, err)
+
+	src, err := generate(pkg.Name, findCausers(pkg, nil))
+	require.NoError(t, err)
+
+	expected, err := os.ReadFile(filepath.Join("testdata", "declared_errorgen.go.golden"))
+	require.NoError(t, err)
+
+	assert.Equal(t, string(expected), string(src))
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/run\n\ngo 1.23\n"), 0o600))
+
+	src, err := os.ReadFile(filepath.Join("testdata", "declared", "declared.go"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "declared.go"), src, 0o600))
+
+	// Run twice, since the second run must ignore the output of the first.
+	require.NoError(t, run(dir, "", ""))
+	require.NoError(t, run(dir, "", ""))
+
+	generated, err := os.ReadFile(filepath.Join(dir, "declared_errorgen.go"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(generated), "func (self DeclaredError) String()")
+	assert.Contains(t, string(generated), "func (self DeclaredError) Values()")
+
+	build := exec.Command("go", "build", "./...")
+	build.Dir = dir
+	out, err := build.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func TestRunRefusesToOverwrite(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/run\n\ngo 1.23\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "causes.go"), []byte("package run\n\ntype RunError uint\n\nconst RunErrorFailure = RunError(1)\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "run_errorgen.go"), []byte("package run\n"), 0o600))
+
+	require.ErrorContains(t, run(dir, "", ""), "not generated by errorgen")
+}
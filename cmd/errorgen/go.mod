@@ -0,0 +1,16 @@
+module github.com/wspowell/errors/cmd/errorgen
+
+go 1.23.0
+
+require (
+	github.com/stretchr/testify v1.8.1
+	golang.org/x/tools v0.30.0
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	golang.org/x/mod v0.23.0 // indirect
+	golang.org/x/sync v0.11.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,94 @@
+// Command errorgen generates methods for Causer enums.
+//
+// For every type in a package whose underlying type satisfies errors.Causer, errorgen emits:
+//
+//	func (self T) String() string
+//	func (self T) GoString() string
+//	func (self T) Values() []T
+//	func (self T) IsValid() bool
+//	func ParseT(name string) (T, bool)
+//
+// Implementing String() keeps Error.Error() off of the fmt.Sprintf() reflection path.
+//
+// Methods that are already declared by hand are not generated. The previous output file is
+// ignored while loading the package, so errorgen can always be re-run.
+//
+// The name of each cause is driven by the comments on its constant:
+//  1. A trailing line comment: ExampleErrorOtherFailure // Other failure
+//  2. Otherwise, the first line of the doc comment.
+//  3. Otherwise, the constant name without the type prefix: ExampleErrorOtherFailure -> "OtherFailure"
+//
+// A trailing period is dropped from comment names. Constants with a value of zero are skipped
+// since zero is reserved for Ok.
+//
+// Usage:
+//
+//	//go:generate go run github.com/wspowell/errors/cmd/errorgen
+//
+// Flags:
+//
+//	-type   comma separated list of type names (default: all Causer types)
+//	-output output file name (default: <package>_errorgen.go)
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	typeNames := flag.String("type", "", "comma separated list of type names; default all Causer types")
+	output := flag.String("output", "", "output file name; default <package>_errorgen.go")
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	if err := run(dir, *typeNames, *output); err != nil {
+		fmt.Fprintf(os.Stderr, "errorgen: %s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string, typeNames string, output string) error {
+	var types []string
+	if typeNames != "" {
+		types = strings.Split(typeNames, ",")
+	}
+
+	if output == "" {
+		pkgName, err := packageName(dir)
+		if err != nil {
+			return err
+		}
+
+		output = pkgName + "_errorgen.go"
+	}
+
+	if !filepath.IsAbs(output) {
+		output = filepath.Join(dir, output)
+	}
+
+	pkg, err := loadPackage(dir, output)
+	if err != nil {
+		return err
+	}
+
+	causers := findCausers(pkg, types)
+	if len(causers) == 0 {
+		return fmt.Errorf("no Causer types found in %s", pkg.PkgPath)
+	}
+
+	src, err := generate(pkg.Name, causers)
+	if err != nil {
+		return err
+	}
+
+	//nolint:gosec,gomnd // reason: generated source files are world readable
+	return os.WriteFile(output, src, 0o644)
+}
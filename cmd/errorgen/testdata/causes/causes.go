@@ -0,0 +1,25 @@
+package causes
+
+type ExampleError uint
+
+const (
+	// Internal failure.
+	ExampleErrorInternalFailure = ExampleError(iota + 1)
+	ExampleErrorOtherFailure    // Other failure
+	ExampleErrorNoComment
+)
+
+// ExampleErrorAlias shares a value and is ignored.
+const ExampleErrorAlias = ExampleErrorNoComment
+
+type SmallError uint8
+
+const (
+	SmallErrorFailure = SmallError(iota + 1)
+)
+
+type NotCauserError int
+
+const NotCauserErrorFailure = NotCauserError(1)
+
+type NoValuesError uint
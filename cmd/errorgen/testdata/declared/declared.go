@@ -0,0 +1,23 @@
+package declared
+
+type DeclaredError uint
+
+const (
+	DeclaredErrorNotFound = DeclaredError(iota + 1)
+	DeclaredErrorTimeout
+)
+
+func (self DeclaredError) String() string {
+	switch self {
+	case DeclaredErrorNotFound:
+		return "not found"
+	case DeclaredErrorTimeout:
+		return "timeout"
+	}
+
+	return "Ok"
+}
+
+func (self DeclaredError) IsValid() bool {
+	return self == DeclaredErrorNotFound || self == DeclaredErrorTimeout
+}
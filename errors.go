@@ -19,22 +19,39 @@ import (
 // Enum types should follow a pattern of: type <Name>Error uint
 // Enum values should follow a pattern of: <Name>Error<FailureCase>
 // Enum values MUST start at 1 and can utilize: <Name>Error(iota + 1)
+//
+// Any unsigned integer width may be used. A narrower width, such as uint8, shrinks Error
+// (and any Result holding it), which adds up in large batches.
 type Causer interface {
-	~uint
+	~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uint
 }
 
 // Error instance whose Cause is T.
 //
 // An Error is only storage for context for the Cause that triggered the error.
+//
+// Prefer a named field over embedding Error in another struct. Embedding promotes the encoding,
+// formatting, and logging methods of Error, which then only encode, format, or log the Cause.
+//
+// When built with the errorsdebug build tag, an Error also stores the stack trace where
+// it was created. Errors with the same Cause are then no longer equal with ==, so compare
+// their Cause instead.
 type Error[T Causer] struct {
+	// Zero size unless built with the errorsdebug build tag.
+	// Declared first since a trailing zero size field adds padding.
+	stack
+
 	Cause T
 }
 
 // New Error instance of a given Cause.
 func New[T Causer](cause T) Error[T] {
-	return Error[T]{
+	err := Error[T]{
 		Cause: cause,
 	}
+	err.record()
+
+	return err
 }
 
 // Error string representation.
@@ -42,17 +59,28 @@ func New[T Causer](cause T) Error[T] {
 // Satisfies golang's Error() string interface.
 //
 // For best performance, implement Stringer for the Cause type.
-// Not implementing Stringer will require reflection via fmt.Sprintf().
+// Otherwise, the Message (or Code) of the registered CauseInfo is used.
+// Not implementing Stringer or registering the Cause will build the name Type(code).
+//
+// See: AppendError()
 func (self Error[T]) Error() string {
 	if asStringer, ok := any(self.Cause).(fmt.Stringer); ok {
 		return asStringer.String()
 	}
 
-	if self.Cause == 0 {
-		return fmt.Sprintf("%T(Ok)", self.Cause)
+	if info, ok := Lookup(self.Cause); ok {
+		if info.Message != "" {
+			return info.Message
+		}
+
+		if info.Code != "" {
+			return info.Code
+		}
 	}
 
-	return fmt.Sprintf("%T(%d)", self.Cause, self.Cause)
+	var buf [64]byte
+
+	return string(AppendCause(buf[:0], self.Cause))
 }
 
 // IsOk returns true if this is an Ok Error instance.
@@ -4,6 +4,7 @@ import (
 	goerrors "errors"
 	"fmt"
 	"testing"
+	"unsafe"
 
 	"github.com/wspowell/errors"
 )
@@ -13,6 +14,8 @@ var (
 	goErrGLOBAL  error
 	errorGLOBAL  errors.Error[TestError]
 	outputGLOBAL string
+	bytesGLOBAL  []byte
+	countGLOBAL  int
 )
 
 func errorFn() errors.Error[TestError] {
@@ -73,6 +76,76 @@ func BenchmarkErrorsHandle(b *testing.B) {
 	outputGLOBAL = errString
 }
 
+func BenchmarkErrorsErrorNoStringer(b *testing.B) {
+	var errString string
+	err := errors.New(NoStringerError(1))
+	for i := 0; i < b.N; i++ {
+		errString = err.Error()
+	}
+
+	// Ensure that the compiler is not optimizing away the call.
+	b.StopTimer()
+	outputGLOBAL = errString
+}
+
+func BenchmarkErrorsAppendError(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	err := errors.New(TestErrorInternalFailure)
+	for i := 0; i < b.N; i++ {
+		buf = err.AppendError(buf[:0])
+	}
+
+	// Ensure that the compiler is not optimizing away the call.
+	b.StopTimer()
+	bytesGLOBAL = buf
+}
+
+func BenchmarkErrorsAppendErrorNoStringer(b *testing.B) {
+	buf := make([]byte, 0, 64)
+	err := errors.New(NoStringerError(1))
+	for i := 0; i < b.N; i++ {
+		buf = err.AppendError(buf[:0])
+	}
+
+	// Ensure that the compiler is not optimizing away the call.
+	b.StopTimer()
+	bytesGLOBAL = buf
+}
+
+// benchmarkWidth fills and scans a batch of errors to compare Causer widths.
+func benchmarkWidth[T errors.Causer](b *testing.B) {
+	errs := make([]errors.Error[T], 4096)
+	size := unsafe.Sizeof(errs[0])
+	b.SetBytes(int64(size) * int64(len(errs)))
+	b.ReportMetric(float64(size), "B/error")
+
+	var count int
+	for i := 0; i < b.N; i++ {
+		for index := range errs {
+			errs[index] = errors.New(T(index % 3))
+		}
+
+		count = 0
+		for _, err := range errs {
+			if err.IsErr() {
+				count++
+			}
+		}
+	}
+
+	// Ensure that the compiler is not optimizing away the call.
+	b.StopTimer()
+	countGLOBAL = count
+}
+
+func BenchmarkErrorsWidth(b *testing.B) {
+	b.Run("uint8", benchmarkWidth[Uint8Error])
+	b.Run("uint16", benchmarkWidth[Uint16Error])
+	b.Run("uint32", benchmarkWidth[Uint32Error])
+	b.Run("uint64", benchmarkWidth[Uint64Error])
+	b.Run("uint", benchmarkWidth[TestError])
+}
+
 func BenchmarkGoerrorsNew(b *testing.B) {
 	var err error
 	for i := 0; i < b.N; i++ {
@@ -1,9 +1,13 @@
 package errors_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/wspowell/errors"
 )
@@ -57,6 +61,22 @@ func TestOk(t *testing.T) {
 	assert.Equal(t, "Ok", err.Error())
 }
 
+type embeddedError struct {
+	errors.Error[TestError]
+	Detail string
+}
+
+func TestEmbeddedError(t *testing.T) {
+	// Embedding promotes the methods of Error, which only see the Cause.
+	err := embeddedError{Error: errors.New(TestErrorMyBad), Detail: "missing"}
+	assert.Equal(t, "MyBad", fmt.Sprintf("%v", err))
+	assert.NotContains(t, err.LogValue().String(), "missing")
+
+	data, marshalErr := json.Marshal(err)
+	require.NoError(t, marshalErr)
+	assert.Equal(t, `"MyBad"`, string(data))
+}
+
 type NoStringerError uint
 
 const (
@@ -83,3 +103,36 @@ func TestNoStringerOk(t *testing.T) {
 	assert.NotEqual(t, TestError(0), err.Cause)
 	assert.Equal(t, "errors_test.NoStringerError(Ok)", err.Error())
 }
+
+type Uint8Error uint8
+
+type Uint16Error uint16
+
+type Uint32Error uint32
+
+type Uint64Error uint64
+
+func testWidth[T errors.Causer](t *testing.T, name string) {
+	t.Helper()
+
+	err := errors.New(T(1))
+	assert.True(t, err.IsErr())
+	assert.False(t, err.IsOk())
+	assert.Equal(t, "errors_test."+name+"(1)", err.Error())
+
+	ok := errors.Ok[T]()
+	assert.False(t, ok.IsErr())
+	assert.True(t, ok.IsOk())
+	assert.Equal(t, "errors_test."+name+"(Ok)", ok.Error())
+}
+
+func TestCauserWidths(t *testing.T) {
+	testWidth[Uint8Error](t, "Uint8Error")
+	testWidth[Uint16Error](t, "Uint16Error")
+	testWidth[Uint32Error](t, "Uint32Error")
+	testWidth[Uint64Error](t, "Uint64Error")
+	testWidth[NoStringerError](t, "NoStringerError")
+
+	maxErr := errors.New(Uint64Error(math.MaxUint64))
+	assert.Equal(t, "errors_test.Uint64Error(18446744073709551615)", maxErr.Error())
+}
@@ -0,0 +1,91 @@
+package errors
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Format the Error.
+//
+// Satisfies fmt.Formatter.
+//
+//	%v, %s  the Error() string
+//	%q      the quoted Error() string
+//	%d      the numeric value of the Cause (also %b, %o, %O, %x, %X)
+//	%+v     the Error() string, Cause type, numeric value, registered CauseInfo, and stack trace, if recorded
+//	%#v     Go syntax, such as errors.New(pkg.ExampleErrorFailure)
+//
+// Go syntax uses the GoString() of the Cause, if implemented, otherwise a conversion such as pkg.ExampleError(1).
+func (self Error[T]) Format(state fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		switch {
+		case state.Flag('#'):
+			io.WriteString(state, self.GoString()) //nolint:errcheck // reason: fmt.State writes do not fail
+		case state.Flag('+'):
+			self.formatVerbose(state)
+		default:
+			fmt.Fprintf(state, fmt.FormatString(state, 's'), self.Error())
+		}
+	case 's', 'q':
+		fmt.Fprintf(state, fmt.FormatString(state, verb), self.Error())
+	case 'd', 'b', 'o', 'O', 'x', 'X':
+		fmt.Fprintf(state, fmt.FormatString(state, verb), uint64(self.Cause))
+	default:
+		fmt.Fprintf(state, "%%!%c(%T=%s)", verb, self, self.Error())
+	}
+}
+
+// GoString of the Error in Go syntax.
+//
+// Satisfies fmt.GoStringer.
+func (self Error[T]) GoString() string {
+	if self.IsOk() {
+		return fmt.Sprintf("errors.Ok[%T]()", self.Cause)
+	}
+
+	return "errors.New(" + causeGoString(self.Cause) + ")"
+}
+
+func causeGoString[T Causer](cause T) string {
+	if goStringer, ok := any(cause).(fmt.GoStringer); ok {
+		return goStringer.GoString()
+	}
+
+	return fmt.Sprintf("%T(%d)", cause, cause)
+}
+
+// formatVerbose writes: <name> (<type>=<code>[, <metadata>...])[\n<stack>]
+func (self Error[T]) formatVerbose(state fmt.State) {
+	var builder strings.Builder
+
+	builder.WriteString(self.Error())
+	builder.WriteString(" (")
+	builder.WriteString(fmt.Sprintf("%T", self.Cause))
+	builder.WriteString("=")
+	builder.WriteString(strconv.FormatUint(uint64(self.Cause), 10))
+
+	if info, ok := self.Info(); ok {
+		if info.Code != "" {
+			builder.WriteString(", wire_code=" + info.Code)
+		}
+
+		builder.WriteString(", severity=" + info.Severity.String())
+		builder.WriteString(", retryable=" + strconv.FormatBool(info.Retryable))
+		builder.WriteString(", public=" + strconv.FormatBool(info.Public))
+
+		if info.DocsURL != "" {
+			builder.WriteString(", docs=" + info.DocsURL)
+		}
+	}
+
+	builder.WriteString(")")
+
+	io.WriteString(state, builder.String()) //nolint:errcheck // reason: fmt.State writes do not fail
+
+	if trace := self.trace(); len(trace) != 0 {
+		fmt.Fprintf(state, "\n%+v", trace)
+	}
+}
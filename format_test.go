@@ -0,0 +1,55 @@
+package errors_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+)
+
+type GoStringError uint
+
+const (
+	GoStringErrorFailure = GoStringError(iota + 1)
+)
+
+func (self GoStringError) GoString() string {
+	if self == GoStringErrorFailure {
+		return "errors_test.GoStringErrorFailure"
+	}
+
+	return fmt.Sprintf("errors_test.GoStringError(%d)", uint(self))
+}
+
+func TestFormat(t *testing.T) {
+	err := errors.New(TestErrorInternalFailure)
+	assert.Equal(t, "InternalFailure", fmt.Sprintf("%v", err))
+	assert.Equal(t, "InternalFailure", fmt.Sprintf("%s", err))
+	assert.Equal(t, `"InternalFailure"`, fmt.Sprintf("%q", err))
+	assert.Equal(t, "2", fmt.Sprintf("%d", err))
+	assert.Equal(t, "002", fmt.Sprintf("%03d", err))
+	assert.Equal(t, "0x2", fmt.Sprintf("%#x", err))
+	assert.Equal(t, "InternalFailure   |", fmt.Sprintf("%-18v|", err))
+	assert.Equal(t, "InternalFailure (errors_test.TestError=2)", fmt.Sprintf("%+v", err))
+	assert.Equal(t, "errors.New(errors_test.TestError(2))", fmt.Sprintf("%#v", err))
+	assert.Equal(t, "%!t(errors.Error[github.com/wspowell/errors_test.TestError]=InternalFailure)", fmt.Sprintf("%t", err))
+}
+
+func TestFormatOk(t *testing.T) {
+	err := errors.Ok[TestError]()
+	assert.Equal(t, "Ok", fmt.Sprintf("%v", err))
+	assert.Equal(t, "0", fmt.Sprintf("%d", err))
+	assert.Equal(t, "Ok (errors_test.TestError=0)", fmt.Sprintf("%+v", err))
+	assert.Equal(t, "errors.Ok[errors_test.TestError]()", fmt.Sprintf("%#v", err))
+}
+
+func TestFormatGoStringer(t *testing.T) {
+	assert.Equal(t, "errors.New(errors_test.GoStringErrorFailure)", fmt.Sprintf("%#v", errors.New(GoStringErrorFailure)))
+}
+
+func TestFormatRegistered(t *testing.T) {
+	err := errors.New(RegisteredErrorNotFound)
+	assert.Equal(t, "Resource not found (errors_test.RegisteredError=1, wire_code=not_found, severity=info, retryable=false, public=true, docs=https://example.com/errors/not_found)", fmt.Sprintf("%+v", err))
+}
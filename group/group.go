@@ -0,0 +1,162 @@
+// Package group runs concurrent tasks that return typed errors.
+//
+// Group is the errors.Error counterpart of golang.org/x/sync/errgroup.
+package group
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/wspowell/errors"
+)
+
+// Group of tasks that each return an errors.Error[C].
+//
+// A zero Group is valid, has no limit on active tasks, and does not cancel on failure.
+type Group[C errors.Causer] struct {
+	cancel func(error)
+
+	waitGroup sync.WaitGroup
+	semaphore chan struct{}
+
+	mutex    sync.Mutex
+	tasks    int
+	first    errors.Error[C]
+	failures map[int]errors.Error[C]
+}
+
+// WithContext returns a new Group and a context derived from ctx.
+//
+// The derived context is cancelled when a task first fails or when Wait() returns, whichever occurs first.
+// The cause of the cancellation, via context.Cause(), is the failed errors.Error[C].
+func WithContext[C errors.Causer](ctx context.Context) (*Group[C], context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	return &Group[C]{
+		cancel: cancel,
+	}, ctx
+}
+
+// SetLimit of active tasks to at most n. A negative value means no limit.
+//
+// Any subsequent call to Go() blocks until a task can be started.
+// The limit must not be changed while tasks are active.
+func (self *Group[C]) SetLimit(n int) {
+	if n < 0 {
+		self.semaphore = nil
+
+		return
+	}
+
+	if len(self.semaphore) != 0 {
+		panic(fmt.Errorf("group: modify limit while %d tasks are still active", len(self.semaphore)))
+	}
+
+	self.semaphore = make(chan struct{}, n)
+}
+
+// Go runs fn in a new goroutine.
+//
+// Blocks until the task can be started if a limit has been set.
+func (self *Group[C]) Go(fn func() errors.Error[C]) {
+	if self.semaphore != nil {
+		self.semaphore <- struct{}{}
+	}
+
+	self.start(fn)
+}
+
+// TryGo runs fn in a new goroutine only if the limit of active tasks has not been reached.
+//
+// Returns true if the task was started.
+func (self *Group[C]) TryGo(fn func() errors.Error[C]) bool {
+	if self.semaphore != nil {
+		select {
+		case self.semaphore <- struct{}{}:
+		default:
+			return false
+		}
+	}
+
+	self.start(fn)
+
+	return true
+}
+
+func (self *Group[C]) start(fn func() errors.Error[C]) {
+	self.mutex.Lock()
+	index := self.tasks
+	self.tasks++
+	self.mutex.Unlock()
+
+	self.waitGroup.Add(1)
+
+	go func() {
+		defer self.done()
+
+		if err := fn(); err.IsErr() {
+			self.fail(index, err)
+		}
+	}()
+}
+
+func (self *Group[C]) done() {
+	if self.semaphore != nil {
+		<-self.semaphore
+	}
+
+	self.waitGroup.Done()
+}
+
+func (self *Group[C]) fail(index int, err errors.Error[C]) {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if self.failures == nil {
+		self.failures = map[int]errors.Error[C]{}
+	}
+
+	self.failures[index] = err
+
+	if self.first.IsOk() {
+		self.first = err
+
+		if self.cancel != nil {
+			self.cancel(err)
+		}
+	}
+}
+
+// Wait for all tasks to complete and return the first failure, or Ok.
+func (self *Group[C]) Wait() errors.Error[C] {
+	self.wait()
+
+	return self.first
+}
+
+// wait for all tasks to complete and release the context of the Group.
+func (self *Group[C]) wait() {
+	self.waitGroup.Wait()
+
+	if self.cancel != nil {
+		self.cancel(nil)
+	}
+}
+
+// WaitAll waits for all tasks to complete and returns every failure in the order the tasks were started.
+func (self *Group[C]) WaitAll() []errors.Error[C] {
+	self.wait()
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	var failures []errors.Error[C]
+	for index := 0; index < self.tasks; index++ {
+		if err, ok := self.failures[index]; ok {
+			failures = append(failures, err)
+		}
+	}
+
+	return failures
+}
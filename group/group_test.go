@@ -0,0 +1,161 @@
+package group_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/group"
+)
+
+type TestError uint
+
+const (
+	TestErrorOne = TestError(iota + 1)
+	TestErrorTwo
+	TestErrorCancelled
+)
+
+func (self TestError) String() string {
+	switch self {
+	case TestErrorOne:
+		return "One"
+	case TestErrorTwo:
+		return "Two"
+	case TestErrorCancelled:
+		return "Cancelled"
+	}
+
+	return "Ok"
+}
+
+func TestZeroGroup(t *testing.T) {
+	t.Parallel()
+
+	var tasks group.Group[TestError]
+
+	var count atomic.Int32
+	for i := 0; i < 5; i++ {
+		tasks.Go(func() errors.Error[TestError] {
+			count.Add(1)
+
+			return errors.Ok[TestError]()
+		})
+	}
+
+	assert.True(t, tasks.Wait().IsOk())
+	assert.Equal(t, int32(5), count.Load())
+}
+
+func TestWithContextCancelsOnFailure(t *testing.T) {
+	t.Parallel()
+
+	tasks, ctx := group.WithContext[TestError](context.Background())
+
+	tasks.Go(func() errors.Error[TestError] {
+		<-ctx.Done()
+
+		return errors.New(TestErrorCancelled)
+	})
+	tasks.Go(func() errors.Error[TestError] {
+		return errors.New(TestErrorOne)
+	})
+
+	assert.Equal(t, errors.New(TestErrorOne), tasks.Wait())
+	assert.Equal(t, errors.New(TestErrorOne), context.Cause(ctx))
+}
+
+func TestWithContextCancelsOnWait(t *testing.T) {
+	t.Parallel()
+
+	tasks, ctx := group.WithContext[TestError](context.Background())
+	tasks.Go(func() errors.Error[TestError] {
+		return errors.Ok[TestError]()
+	})
+
+	assert.True(t, tasks.Wait().IsOk())
+	assert.ErrorIs(t, ctx.Err(), context.Canceled)
+}
+
+func TestWaitAll(t *testing.T) {
+	t.Parallel()
+
+	var tasks group.Group[TestError]
+
+	tasks.Go(func() errors.Error[TestError] {
+		time.Sleep(time.Millisecond)
+
+		return errors.New(TestErrorTwo)
+	})
+	tasks.Go(func() errors.Error[TestError] {
+		return errors.Ok[TestError]()
+	})
+	tasks.Go(func() errors.Error[TestError] {
+		return errors.New(TestErrorOne)
+	})
+
+	assert.Equal(t, []errors.Error[TestError]{
+		errors.New(TestErrorTwo),
+		errors.New(TestErrorOne),
+	}, tasks.WaitAll())
+}
+
+func TestSetLimit(t *testing.T) {
+	t.Parallel()
+
+	var tasks group.Group[TestError]
+	tasks.SetLimit(2)
+
+	var active atomic.Int32
+	var maxActive atomic.Int32
+
+	for i := 0; i < 10; i++ {
+		tasks.Go(func() errors.Error[TestError] {
+			current := active.Add(1)
+			defer active.Add(-1)
+
+			for {
+				previous := maxActive.Load()
+				if current <= previous || maxActive.CompareAndSwap(previous, current) {
+					break
+				}
+			}
+
+			time.Sleep(time.Millisecond)
+
+			return errors.Ok[TestError]()
+		})
+	}
+
+	assert.True(t, tasks.Wait().IsOk())
+	assert.LessOrEqual(t, maxActive.Load(), int32(2))
+}
+
+func TestTryGo(t *testing.T) {
+	t.Parallel()
+
+	var tasks group.Group[TestError]
+	tasks.SetLimit(1)
+
+	release := make(chan struct{})
+	assert.True(t, tasks.TryGo(func() errors.Error[TestError] {
+		<-release
+
+		return errors.Ok[TestError]()
+	}))
+	assert.False(t, tasks.TryGo(func() errors.Error[TestError] {
+		return errors.Ok[TestError]()
+	}))
+
+	close(release)
+	assert.True(t, tasks.Wait().IsOk())
+
+	assert.True(t, tasks.TryGo(func() errors.Error[TestError] {
+		return errors.New(TestErrorOne)
+	}))
+	assert.Equal(t, errors.New(TestErrorOne), tasks.Wait())
+}
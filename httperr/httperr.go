@@ -0,0 +1,80 @@
+// Package httperr maps errors.Error to HTTP responses.
+package httperr
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+// StatusCoder provides the HTTP status code of a Cause.
+//
+// Causer types may optionally implement StatusCoder to control the status code of their Errors.
+type StatusCoder interface {
+	HTTPStatus() int
+}
+
+// Body of an error response.
+type Body struct {
+	Error string `json:"error"`
+}
+
+// Status code of an Error.
+//
+// Ok is 200. If the Cause does not implement StatusCoder, the status is 500.
+func Status[C errors.Causer](err errors.Error[C]) int {
+	if err.IsOk() {
+		return http.StatusOK
+	}
+
+	if statusCoder, ok := any(err.Cause).(StatusCoder); ok {
+		if status := statusCoder.HTTPStatus(); status != 0 {
+			return status
+		}
+	}
+
+	return http.StatusInternalServerError
+}
+
+// WriteError as a JSON response with the mapped status code.
+func WriteError[C errors.Causer](w http.ResponseWriter, err errors.Error[C]) {
+	writeJSON(w, Status(err), Body{
+		Error: err.Error(),
+	})
+}
+
+// Handler adapts a function returning a Result into an http.Handler.
+//
+// An Ok result is written as JSON with status 200.
+// An Err result is written via WriteError().
+func Handler[T any, C errors.Causer](fn func(*http.Request) result.Result[T, errors.Error[C]]) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		res := fn(r)
+		if !res.IsOk() {
+			WriteError(w, res.Error())
+
+			return
+		}
+
+		writeJSON(w, http.StatusOK, res.Value())
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, value any) {
+	body, err := json.Marshal(value)
+	if err != nil {
+		status = http.StatusInternalServerError
+		//nolint:errcheck,errchkjson // reason: Body is always encodable
+		body, _ = json.Marshal(Body{
+			Error: err.Error(),
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	//nolint:errcheck // reason: the status has been written, there is no way to report failure
+	w.Write(body)
+}
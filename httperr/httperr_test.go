@@ -0,0 +1,114 @@
+package httperr_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/httperr"
+	"github.com/wspowell/errors/result"
+)
+
+type TestError uint
+
+const (
+	TestErrorNotFound = TestError(iota + 1)
+	TestErrorInternalFailure
+)
+
+func (self TestError) String() string {
+	switch self {
+	case TestErrorNotFound:
+		return "NotFound"
+	case TestErrorInternalFailure:
+		return "InternalFailure"
+	}
+
+	return "Ok"
+}
+
+func (self TestError) HTTPStatus() int {
+	switch self {
+	case TestErrorNotFound:
+		return http.StatusNotFound
+	case TestErrorInternalFailure:
+		return http.StatusInternalServerError
+	}
+
+	return http.StatusOK
+}
+
+type NoStatusError uint
+
+const (
+	NoStatusErrorFailure = NoStatusError(iota + 1)
+)
+
+func TestStatus(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, http.StatusOK, httperr.Status(errors.Ok[TestError]()))
+	assert.Equal(t, http.StatusNotFound, httperr.Status(errors.New(TestErrorNotFound)))
+	assert.Equal(t, http.StatusInternalServerError, httperr.Status(errors.New(TestErrorInternalFailure)))
+	assert.Equal(t, http.StatusInternalServerError, httperr.Status(errors.New(NoStatusErrorFailure)))
+}
+
+func TestWriteError(t *testing.T) {
+	t.Parallel()
+
+	recorder := httptest.NewRecorder()
+	httperr.WriteError(recorder, errors.New(TestErrorNotFound))
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"error":"NotFound"}`, recorder.Body.String())
+}
+
+type widget struct {
+	ID int `json:"id"`
+}
+
+func getWidget(r *http.Request) result.Result[widget, errors.Error[TestError]] {
+	if r.URL.Query().Get("id") == "1" {
+		return result.Ok[widget, errors.Error[TestError]](widget{ID: 1})
+	}
+
+	return result.Err[widget](errors.New(TestErrorNotFound))
+}
+
+func TestHandlerOk(t *testing.T) {
+	t.Parallel()
+
+	recorder := httptest.NewRecorder()
+	httperr.Handler(getWidget).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/widgets?id=1", nil))
+
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, "application/json", recorder.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"id":1}`, recorder.Body.String())
+}
+
+func TestHandlerErr(t *testing.T) {
+	t.Parallel()
+
+	recorder := httptest.NewRecorder()
+	httperr.Handler(getWidget).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/widgets?id=2", nil))
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.JSONEq(t, `{"error":"NotFound"}`, recorder.Body.String())
+}
+
+func TestHandlerUnencodable(t *testing.T) {
+	t.Parallel()
+
+	handler := httperr.Handler(func(r *http.Request) result.Result[func(), errors.Error[TestError]] {
+		return result.Ok[func(), errors.Error[TestError]](func() {})
+	})
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+}
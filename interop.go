@@ -0,0 +1,36 @@
+package errors
+
+import (
+	goerrors "errors"
+)
+
+// Is returns true if target is an Error[T] with the same Cause.
+//
+// Satisfies the interface used by golang's errors.Is() so that an Error[T]
+// can still be matched after being wrapped by code that only knows error.
+func (self Error[T]) Is(target error) bool {
+	if other, ok := target.(Error[T]); ok {
+		return other.Cause == self.Cause
+	}
+
+	return false
+}
+
+// CauseOf the first Error[T] found in the error chain.
+//
+// Returns false if err does not contain an Error[T] or if the Error[T] is Ok.
+func CauseOf[T Causer](err error) (T, bool) {
+	var target Error[T]
+	if goerrors.As(err, &target) {
+		return target.Cause, target.IsErr()
+	}
+
+	return 0, false
+}
+
+// IsCause returns true if the error chain contains an Error[T] of the given Cause.
+func IsCause[T Causer](err error, cause T) bool {
+	found, ok := CauseOf[T](err)
+
+	return ok && found == cause
+}
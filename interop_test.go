@@ -0,0 +1,54 @@
+package errors_test
+
+import (
+	goerrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+)
+
+func TestIs(t *testing.T) {
+	err := errors.New(TestErrorInternalFailure)
+	assert.True(t, err.Is(errors.New(TestErrorInternalFailure)))
+	assert.False(t, err.Is(errors.New(TestErrorMyBad)))
+	assert.False(t, err.Is(errors.New(NoStringerErrorInternalFailure)))
+	assert.False(t, err.Is(goerrors.New("InternalFailure")))
+
+	wrapped := fmt.Errorf("wrapped: %w", err)
+	assert.True(t, goerrors.Is(wrapped, errors.New(TestErrorInternalFailure)))
+	assert.False(t, goerrors.Is(wrapped, errors.New(TestErrorMyBad)))
+
+	var target errors.Error[TestError]
+	assert.True(t, goerrors.As(wrapped, &target))
+	assert.Equal(t, TestErrorInternalFailure, target.Cause)
+}
+
+func TestCauseOf(t *testing.T) {
+	wrapped := fmt.Errorf("wrapped: %w", errors.New(TestErrorMyBad))
+
+	cause, ok := errors.CauseOf[TestError](wrapped)
+	assert.True(t, ok)
+	assert.Equal(t, TestErrorMyBad, cause)
+
+	noStringerCause, ok := errors.CauseOf[NoStringerError](wrapped)
+	assert.False(t, ok)
+	assert.Equal(t, NoStringerError(0), noStringerCause)
+
+	cause, ok = errors.CauseOf[TestError](errors.Ok[TestError]())
+	assert.False(t, ok)
+	assert.Equal(t, TestError(0), cause)
+
+	cause, ok = errors.CauseOf[TestError](nil)
+	assert.False(t, ok)
+	assert.Equal(t, TestError(0), cause)
+}
+
+func TestIsCause(t *testing.T) {
+	wrapped := fmt.Errorf("wrapped: %w", errors.New(TestErrorMyBad))
+	assert.True(t, errors.IsCause(wrapped, TestErrorMyBad))
+	assert.False(t, errors.IsCause(wrapped, TestErrorInternalFailure))
+	assert.False(t, errors.IsCause(wrapped, NoStringerErrorMyBad))
+}
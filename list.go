@@ -0,0 +1,147 @@
+package errors
+
+import (
+	"encoding/json"
+	"strings"
+	"text/tabwriter"
+)
+
+// FieldError is an Error of a field.
+type FieldError[T Causer] struct {
+	// Path of the field, such as "address.street" or "items[0].name".
+	Path string `json:"path"`
+	// Err of the field.
+	Err Error[T] `json:"error"`
+}
+
+// List of field Errors in the order they were added.
+//
+// List accumulates every failure of a validation without creating an error tree.
+// A zero List is empty and ready to use.
+type List[T Causer] struct {
+	fields *[]FieldError[T]
+	prefix string
+}
+
+// Add the Error of the field at path. Ok Errors are ignored.
+//
+// Returns true if the Error was added.
+func (self *List[T]) Add(path string, err Error[T]) bool {
+	if err.IsOk() {
+		return false
+	}
+
+	if self.fields == nil {
+		self.fields = &[]FieldError[T]{}
+	}
+
+	*self.fields = append(*self.fields, FieldError[T]{
+		Path: joinPath(self.prefix, path),
+		Err:  err,
+	})
+
+	return true
+}
+
+// Nested List of the field at path.
+//
+// Errors added to the nested List are added to this List with the path prefixed.
+func (self *List[T]) Nested(path string) *List[T] {
+	if self.fields == nil {
+		self.fields = &[]FieldError[T]{}
+	}
+
+	return &List[T]{
+		fields: self.fields,
+		prefix: joinPath(self.prefix, path),
+	}
+}
+
+func joinPath(prefix string, path string) string {
+	switch {
+	case prefix == "":
+		return path
+	case path == "":
+		return prefix
+	case strings.HasPrefix(path, "["):
+		return prefix + path
+	}
+
+	return prefix + "." + path
+}
+
+// Len of the List.
+func (self List[T]) Len() int {
+	if self.fields == nil {
+		return 0
+	}
+
+	return len(*self.fields)
+}
+
+// IsOk returns true if the List is empty.
+func (self List[T]) IsOk() bool {
+	return self.Len() == 0
+}
+
+// Fields of the List in the order they were added.
+func (self List[T]) Fields() []FieldError[T] {
+	if self.fields == nil {
+		return nil
+	}
+
+	fields := make([]FieldError[T], len(*self.fields))
+	copy(fields, *self.fields)
+
+	return fields
+}
+
+// First Error added to the List, or Ok if empty.
+func (self List[T]) First() Error[T] {
+	if self.IsOk() {
+		return Ok[T]()
+	}
+
+	return (*self.fields)[0].Err
+}
+
+// Summary of the List as a single Error of cause, or Ok if empty.
+//
+// Used by callers that only want to know that validation failed.
+func (self List[T]) Summary(cause T) Error[T] {
+	if self.IsOk() {
+		return Ok[T]()
+	}
+
+	return New(cause)
+}
+
+// Table of the fields and their Errors with aligned columns.
+func (self List[T]) Table() string {
+	var builder strings.Builder
+
+	//nolint:gomnd // reason: column padding
+	writer := tabwriter.NewWriter(&builder, 0, 0, 2, ' ', 0)
+
+	writer.Write([]byte("PATH\tERROR\n")) //nolint:errcheck // reason: strings.Builder does not fail
+	for _, field := range self.Fields() {
+		writer.Write([]byte(field.Path + "\t" + field.Err.Error() + "\n")) //nolint:errcheck // reason: strings.Builder does not fail
+	}
+
+	writer.Flush() //nolint:errcheck // reason: strings.Builder does not fail
+
+	return builder.String()
+}
+
+// MarshalJSON encodes the List as an array of fields and their Errors.
+//
+// Satisfies json.Marshaler.
+func (self List[T]) MarshalJSON() ([]byte, error) {
+	fields := self.Fields()
+	if fields == nil {
+		fields = []FieldError[T]{}
+	}
+
+	//nolint:wrapcheck // reason: pass through encoding errors
+	return json.Marshal(fields)
+}
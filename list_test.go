@@ -0,0 +1,97 @@
+package errors_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wspowell/errors"
+)
+
+type address struct {
+	Street string
+	City   string
+}
+
+type user struct {
+	Name      string
+	Addresses []address
+}
+
+func validateAddress(list *errors.List[EnumError], value address) {
+	if value.Street == "" {
+		list.Add("street", errors.New(EnumErrorMyBad))
+	}
+
+	if value.City == "" {
+		list.Add("city", errors.New(EnumErrorMyBad))
+	}
+}
+
+func validateUser(value user) errors.List[EnumError] {
+	var list errors.List[EnumError]
+
+	if value.Name == "" {
+		list.Add("name", errors.New(EnumErrorInternalFailure))
+	}
+
+	addresses := list.Nested("addresses")
+	for index, each := range value.Addresses {
+		validateAddress(addresses.Nested("["+strconv.Itoa(index)+"]"), each)
+	}
+
+	return list
+}
+
+func TestListEmpty(t *testing.T) {
+	var list errors.List[EnumError]
+	assert.True(t, list.IsOk())
+	assert.Equal(t, 0, list.Len())
+	assert.Nil(t, list.Fields())
+	assert.True(t, list.First().IsOk())
+	assert.True(t, list.Summary(EnumErrorInternalFailure).IsOk())
+	assert.False(t, list.Add("ok", errors.Ok[EnumError]()))
+	assert.True(t, list.IsOk())
+
+	data, err := json.Marshal(list)
+	require.NoError(t, err)
+	assert.Equal(t, `[]`, string(data))
+}
+
+func TestListNested(t *testing.T) {
+	list := validateUser(user{
+		Addresses: []address{
+			{Street: "Main", City: "Springfield"},
+			{},
+		},
+	})
+
+	assert.False(t, list.IsOk())
+	assert.Equal(t, []errors.FieldError[EnumError]{
+		{Path: "name", Err: errors.New(EnumErrorInternalFailure)},
+		{Path: "addresses[1].street", Err: errors.New(EnumErrorMyBad)},
+		{Path: "addresses[1].city", Err: errors.New(EnumErrorMyBad)},
+	}, list.Fields())
+	assert.Equal(t, errors.New(EnumErrorInternalFailure), list.First())
+	assert.Equal(t, errors.New(EnumErrorMyBad), list.Summary(EnumErrorMyBad))
+}
+
+func TestListTable(t *testing.T) {
+	list := validateUser(user{Addresses: []address{{Street: "Main"}}})
+
+	assert.Equal(t, ""+
+		"PATH               ERROR\n"+
+		"name               InternalFailure\n"+
+		"addresses[0].city  MyBad\n", list.Table())
+}
+
+func TestListJSON(t *testing.T) {
+	list := validateUser(user{Name: "gopher", Addresses: []address{{City: "Springfield"}}})
+
+	data, err := json.Marshal(list)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"path":"addresses[0].street","error":"MyBad"}]`, string(data))
+}
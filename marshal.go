@@ -0,0 +1,225 @@
+package errors
+
+import (
+	"bytes"
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"strconv"
+)
+
+var (
+	// ErrUnknownCause is returned when decoding a name or code that is not a valid Cause.
+	ErrUnknownCause = goerrors.New("unknown cause")
+
+	// ErrNotEnumerable is returned when decoding a name for a Cause type without Values().
+	ErrNotEnumerable = goerrors.New("cause type does not implement Values()")
+)
+
+// enumerable Causer types can list all of their values.
+//
+// Values() and IsValid() are generated by cmd/errorgen.
+type enumerable[T Causer] interface {
+	Values() []T
+}
+
+type validator interface {
+	IsValid() bool
+}
+
+// MarshalText encodes the Cause by name.
+//
+// Satisfies encoding.TextMarshaler.
+//
+// The name is the registered Code of the Cause, or otherwise the String() of the Cause.
+// Causes without a name are encoded as their numeric value.
+//
+// Names from String() can only be decoded if the Cause type also implements Values().
+// See: Numeric
+func (self Error[T]) MarshalText() ([]byte, error) {
+	if name, ok := causeName(self.Cause); ok {
+		return []byte(name), nil
+	}
+
+	return strconv.AppendUint(nil, uint64(self.Cause), 10), nil
+}
+
+// UnmarshalText decodes the Cause from a name or numeric value.
+//
+// Satisfies encoding.TextUnmarshaler.
+//
+// Names are resolved using the registered Codes or the Values() of the Cause type.
+// Unknown names return ErrUnknownCause. Names of a Cause type without Values() return ErrNotEnumerable.
+func (self *Error[T]) UnmarshalText(text []byte) error {
+	if code, err := strconv.ParseUint(string(text), 10, 64); err == nil {
+		return self.setCode(code)
+	}
+
+	cause, err := parseName[T](string(text))
+	if err != nil {
+		return err
+	}
+
+	self.Cause = cause
+
+	return nil
+}
+
+// MarshalJSON encodes the Cause as a JSON string of its name.
+//
+// Satisfies json.Marshaler.
+//
+// Causes without a name are encoded as a JSON number.
+func (self Error[T]) MarshalJSON() ([]byte, error) {
+	if name, ok := causeName(self.Cause); ok {
+		//nolint:wrapcheck // reason: strings always encode
+		return json.Marshal(name)
+	}
+
+	return strconv.AppendUint(nil, uint64(self.Cause), 10), nil
+}
+
+// UnmarshalJSON decodes the Cause from a JSON string name or JSON number.
+//
+// Satisfies json.Unmarshaler.
+//
+// A JSON null is a no-op.
+func (self *Error[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	if len(data) != 0 && data[0] == '"' {
+		var name string
+		if err := json.Unmarshal(data, &name); err != nil {
+			return fmt.Errorf("failed to decode cause: %w", err)
+		}
+
+		cause, err := parseName[T](name)
+		if err != nil {
+			return err
+		}
+
+		self.Cause = cause
+
+		return nil
+	}
+
+	code, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to decode cause: %w", err)
+	}
+
+	return self.setCode(code)
+}
+
+// setCode validates the numeric code before setting the Cause.
+func (self *Error[T]) setCode(code uint64) error {
+	cause := T(code)
+	if uint64(cause) != code {
+		return fmt.Errorf("%w: %T(%d)", ErrUnknownCause, cause, code)
+	}
+
+	if cause != 0 && !isKnown(cause) {
+		return fmt.Errorf("%w: %T(%d)", ErrUnknownCause, cause, code)
+	}
+
+	self.Cause = cause
+
+	return nil
+}
+
+// isKnown returns false if the Cause is not valid or not registered.
+//
+// Causes are assumed known if the Cause type cannot be validated.
+func isKnown[T Causer](cause T) bool {
+	if valid, ok := any(cause).(validator); ok {
+		return valid.IsValid()
+	}
+
+	if catalog, ok := catalogOf[T](); ok {
+		_, ok = catalog.byCause[uint64(cause)]
+
+		return ok
+	}
+
+	return true
+}
+
+// causeName returns the registered Code or String() of the Cause, if available.
+func causeName[T Causer](cause T) (string, bool) {
+	if info, ok := Lookup(cause); ok && info.Code != "" {
+		return info.Code, true
+	}
+
+	if asStringer, ok := any(cause).(fmt.Stringer); ok {
+		return asStringer.String(), true
+	}
+
+	return "", false
+}
+
+// parseName resolves the Cause whose name matches.
+func parseName[T Causer](name string) (T, error) {
+	if cause, ok := lookupCode[T](name); ok {
+		return cause, nil
+	}
+
+	var zero T
+	if okName, ok := causeName(zero); ok && okName == name {
+		return zero, nil
+	}
+
+	values, ok := any(zero).(enumerable[T])
+	if !ok {
+		return zero, fmt.Errorf("%w: %T", ErrNotEnumerable, zero)
+	}
+
+	for _, cause := range values.Values() {
+		if candidate, _ := causeName(cause); candidate == name {
+			return cause, nil
+		}
+	}
+
+	return zero, fmt.Errorf("%w: %T(%q)", ErrUnknownCause, zero, name)
+}
+
+// Numeric Error that is always encoded as the numeric value of its Cause.
+//
+// Use Numeric as the field type in place of Error when the String() of the Cause should not
+// be on the wire, such as when the Cause type does not implement Values():
+//
+//	type response struct {
+//		Err errors.Numeric[MyError] `json:"err"`
+//	}
+//
+// Convert with Numeric[T](err) and Error[T](numeric). Decoding accepts the same input as Error.
+type Numeric[T Causer] Error[T]
+
+// MarshalText encodes the numeric value of the Cause.
+//
+// Satisfies encoding.TextMarshaler.
+func (self Numeric[T]) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(self.Cause), 10), nil
+}
+
+// UnmarshalText decodes the Cause from a name or numeric value.
+//
+// Satisfies encoding.TextUnmarshaler.
+func (self *Numeric[T]) UnmarshalText(text []byte) error {
+	return (*Error[T])(self).UnmarshalText(text)
+}
+
+// MarshalJSON encodes the numeric value of the Cause as a JSON number.
+//
+// Satisfies json.Marshaler.
+func (self Numeric[T]) MarshalJSON() ([]byte, error) {
+	return self.MarshalText()
+}
+
+// UnmarshalJSON decodes the Cause from a JSON string name or JSON number.
+//
+// Satisfies json.Unmarshaler.
+func (self *Numeric[T]) UnmarshalJSON(data []byte) error {
+	return (*Error[T])(self).UnmarshalJSON(data)
+}
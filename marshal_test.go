@@ -0,0 +1,149 @@
+package errors_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wspowell/errors"
+)
+
+type EnumError uint
+
+const (
+	EnumErrorMyBad = EnumError(iota + 1)
+	EnumErrorInternalFailure
+)
+
+func (self EnumError) String() string {
+	switch self {
+	case EnumErrorMyBad:
+		return "MyBad"
+	case EnumErrorInternalFailure:
+		return "InternalFailure"
+	}
+
+	return "Ok"
+}
+
+func (self EnumError) Values() []EnumError {
+	return []EnumError{EnumErrorMyBad, EnumErrorInternalFailure}
+}
+
+func (self EnumError) IsValid() bool {
+	return self == EnumErrorMyBad || self == EnumErrorInternalFailure
+}
+
+type payload struct {
+	Err errors.Error[EnumError] `json:"err"`
+}
+
+type stringerPayload struct {
+	Err errors.Error[TestError] `json:"err"`
+}
+
+func TestMarshalText(t *testing.T) {
+	text, err := errors.New(EnumErrorInternalFailure).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "InternalFailure", string(text))
+
+	text, err = errors.New(NoStringerErrorInternalFailure).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "2", string(text))
+}
+
+func TestUnmarshalText(t *testing.T) {
+	var decoded errors.Error[EnumError]
+	require.NoError(t, decoded.UnmarshalText([]byte("MyBad")))
+	assert.Equal(t, errors.New(EnumErrorMyBad), decoded)
+
+	require.NoError(t, decoded.UnmarshalText([]byte("Ok")))
+	assert.True(t, decoded.IsOk())
+
+	require.NoError(t, decoded.UnmarshalText([]byte("2")))
+	assert.Equal(t, errors.New(EnumErrorInternalFailure), decoded)
+
+	assert.ErrorIs(t, decoded.UnmarshalText([]byte("Unknown")), errors.ErrUnknownCause)
+	assert.ErrorIs(t, decoded.UnmarshalText([]byte("3")), errors.ErrUnknownCause)
+}
+
+func TestMarshalJSONNotEnumerable(t *testing.T) {
+	data, err := json.Marshal(stringerPayload{Err: errors.New(TestErrorMyBad)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"err":"MyBad"}`, string(data))
+
+	var decoded stringerPayload
+	assert.ErrorIs(t, json.Unmarshal(data, &decoded), errors.ErrNotEnumerable)
+}
+
+type numericPayload struct {
+	Err errors.Numeric[TestError] `json:"err"`
+}
+
+func TestMarshalJSONNumeric(t *testing.T) {
+	data, err := json.Marshal(numericPayload{Err: errors.Numeric[TestError](errors.New(TestErrorMyBad))})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"err":1}`, string(data))
+
+	var decoded numericPayload
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, TestErrorMyBad, decoded.Err.Cause)
+
+	text, err := errors.Numeric[EnumError](errors.New(EnumErrorInternalFailure)).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "2", string(text))
+
+	var numeric errors.Numeric[EnumError]
+	require.NoError(t, numeric.UnmarshalText([]byte("MyBad")))
+	assert.Equal(t, EnumErrorMyBad, numeric.Cause)
+	assert.ErrorIs(t, numeric.UnmarshalText([]byte("3")), errors.ErrUnknownCause)
+}
+
+func TestUnmarshalTextNotEnumerable(t *testing.T) {
+	var decoded errors.Error[TestError]
+	assert.ErrorIs(t, decoded.UnmarshalText([]byte("MyBad")), errors.ErrNotEnumerable)
+
+	require.NoError(t, decoded.UnmarshalText([]byte("1")))
+	assert.Equal(t, errors.New(TestErrorMyBad), decoded)
+}
+
+func TestUnmarshalTextOverflow(t *testing.T) {
+	var err errors.Error[Uint8Error]
+	require.NoError(t, err.UnmarshalText([]byte("255")))
+	assert.Equal(t, Uint8Error(255), err.Cause)
+	assert.ErrorIs(t, err.UnmarshalText([]byte("256")), errors.ErrUnknownCause)
+}
+
+func TestMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(payload{Err: errors.New(EnumErrorMyBad)})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"err":"MyBad"}`, string(data))
+
+	data, err = json.Marshal(errors.New(NoStringerErrorMyBad))
+	require.NoError(t, err)
+	assert.Equal(t, `1`, string(data))
+
+	data, err = json.Marshal(map[errors.Error[EnumError]]int{errors.New(EnumErrorMyBad): 1})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"MyBad":1}`, string(data))
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var decoded payload
+	require.NoError(t, json.Unmarshal([]byte(`{"err":"InternalFailure"}`), &decoded))
+	assert.Equal(t, errors.New(EnumErrorInternalFailure), decoded.Err)
+
+	decoded = payload{}
+	require.NoError(t, json.Unmarshal([]byte(`{"err":1}`), &decoded))
+	assert.Equal(t, errors.New(EnumErrorMyBad), decoded.Err)
+
+	decoded = payload{}
+	require.NoError(t, json.Unmarshal([]byte(`{"err":null}`), &decoded))
+	assert.True(t, decoded.Err.IsOk())
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"err":"Unknown"}`), &decoded), errors.ErrUnknownCause)
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"err":7}`), &decoded), errors.ErrUnknownCause)
+	assert.Error(t, json.Unmarshal([]byte(`{"err":-1}`), &decoded))
+}
@@ -0,0 +1,150 @@
+// Package option provides a value that may be absent.
+//
+// Option expresses "maybe absent" without abusing an error Cause for the absent case.
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/wspowell/errors/result"
+)
+
+// Option of a value that is either Some value or None.
+type Option[T any] struct {
+	value T
+	some  bool
+}
+
+// Some value.
+func Some[T any](value T) Option[T] {
+	return Option[T]{
+		value: value,
+		some:  true,
+	}
+}
+
+// None or no value present.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// FromPointer is None if the pointer is nil, otherwise Some of the pointed to value.
+func FromPointer[T any](value *T) Option[T] {
+	if value == nil {
+		return None[T]()
+	}
+
+	return Some(*value)
+}
+
+// FromResult is Some value of an Ok result, otherwise None.
+func FromResult[T any, E result.Error](res result.Result[T, E]) Option[T] {
+	if res.IsOk() {
+		return Some(res.Value())
+	}
+
+	return None[T]()
+}
+
+// OkOr converts Some value into an Ok result, otherwise an Err result of err.
+func OkOr[T any, E result.Error](self Option[T], err E) result.Result[T, E] {
+	if self.some {
+		return result.Ok[T, E](self.value)
+	}
+
+	return result.Err[T](err)
+}
+
+// Map Some value, otherwise None is passed through.
+func Map[T any, S any](self Option[T], fn func(T) S) Option[S] {
+	if self.some {
+		return Some(fn(self.value))
+	}
+
+	return None[S]()
+}
+
+// IsSome returns true if a value is present.
+func (self Option[T]) IsSome() bool {
+	return self.some
+}
+
+// IsNone returns true if no value is present.
+func (self Option[T]) IsNone() bool {
+	return !self.some
+}
+
+// Get the value and whether it is present.
+func (self Option[T]) Get() (T, bool) {
+	return self.value, self.some
+}
+
+// Value of Some.
+//
+// Note: If called on None, this will be the zero value of T.
+func (self Option[T]) Value() T {
+	return self.value
+}
+
+// ValueOr default value if None.
+func (self Option[T]) ValueOr(defaultValue T) T {
+	if self.some {
+		return self.value
+	}
+
+	return defaultValue
+}
+
+// OrElse returns this Option if Some, otherwise the Option returned by fn.
+func (self Option[T]) OrElse(fn func() Option[T]) Option[T] {
+	if self.some {
+		return self
+	}
+
+	return fn()
+}
+
+// Pointer to a copy of the value, or nil if None.
+func (self Option[T]) Pointer() *T {
+	if self.some {
+		value := self.value
+
+		return &value
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes None as null and Some as the value.
+//
+// Satisfies json.Marshaler.
+func (self Option[T]) MarshalJSON() ([]byte, error) {
+	if !self.some {
+		return []byte("null"), nil
+	}
+
+	//nolint:wrapcheck // reason: pass through encoding errors of the value
+	return json.Marshal(self.value)
+}
+
+// UnmarshalJSON decodes null as None and anything else as Some value.
+//
+// Satisfies json.Unmarshaler.
+func (self *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*self = None[T]()
+
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		//nolint:wrapcheck // reason: pass through decoding errors of the value
+		return err
+	}
+
+	*self = Some(value)
+
+	return nil
+}
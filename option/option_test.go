@@ -0,0 +1,117 @@
+package option_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/option"
+	"github.com/wspowell/errors/result"
+)
+
+type TestError uint
+
+const (
+	TestErrorNotFound = TestError(iota + 1)
+)
+
+func TestSome(t *testing.T) {
+	t.Parallel()
+
+	opt := option.Some(1)
+	assert.True(t, opt.IsSome())
+	assert.False(t, opt.IsNone())
+	assert.Equal(t, 1, opt.Value())
+	assert.Equal(t, 1, opt.ValueOr(2))
+
+	value, ok := opt.Get()
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+}
+
+func TestNone(t *testing.T) {
+	t.Parallel()
+
+	opt := option.None[int]()
+	assert.False(t, opt.IsSome())
+	assert.True(t, opt.IsNone())
+	assert.Equal(t, 0, opt.Value())
+	assert.Equal(t, 2, opt.ValueOr(2))
+
+	value, ok := opt.Get()
+	assert.False(t, ok)
+	assert.Equal(t, 0, value)
+
+	assert.Equal(t, option.None[int](), option.Option[int]{})
+}
+
+func TestOrElse(t *testing.T) {
+	t.Parallel()
+
+	other := func() option.Option[int] { return option.Some(2) }
+
+	assert.Equal(t, option.Some(1), option.Some(1).OrElse(other))
+	assert.Equal(t, option.Some(2), option.None[int]().OrElse(other))
+}
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, option.Some("1"), option.Map(option.Some(1), strconv.Itoa))
+	assert.Equal(t, option.None[string](), option.Map(option.None[int](), strconv.Itoa))
+}
+
+func TestPointer(t *testing.T) {
+	t.Parallel()
+
+	value := 1
+	assert.Equal(t, option.Some(1), option.FromPointer(&value))
+	assert.Equal(t, option.None[int](), option.FromPointer[int](nil))
+
+	pointer := option.Some(1).Pointer()
+	require.NotNil(t, pointer)
+	assert.Equal(t, 1, *pointer)
+	assert.Nil(t, option.None[int]().Pointer())
+}
+
+func TestResult(t *testing.T) {
+	t.Parallel()
+
+	res := option.OkOr(option.Some(1), errors.New(TestErrorNotFound))
+	assert.True(t, res.IsOk())
+	assert.Equal(t, 1, res.ValueOr(0))
+
+	res = option.OkOr(option.None[int](), errors.New(TestErrorNotFound))
+	assert.False(t, res.IsOk())
+	assert.Equal(t, errors.New(TestErrorNotFound), res.Error())
+
+	assert.Equal(t, option.Some(1), option.FromResult(result.Ok[int, errors.Error[TestError]](1)))
+	assert.Equal(t, option.None[int](), option.FromResult(result.Err[int](errors.New(TestErrorNotFound))))
+}
+
+type payload struct {
+	Name option.Option[string] `json:"name"`
+	Age  option.Option[int]    `json:"age"`
+}
+
+func TestJSON(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(payload{Name: option.Some("gopher"), Age: option.None[int]()})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"gopher","age":null}`, string(data))
+
+	var decoded payload
+	require.NoError(t, json.Unmarshal([]byte(`{"name":null,"age":3}`), &decoded))
+	assert.Equal(t, payload{Name: option.None[string](), Age: option.Some(3)}, decoded)
+
+	decoded = payload{}
+	require.NoError(t, json.Unmarshal([]byte(`{}`), &decoded))
+	assert.True(t, decoded.Name.IsNone())
+
+	assert.Error(t, json.Unmarshal([]byte(`{"age":"three"}`), &decoded))
+}
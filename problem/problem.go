@@ -0,0 +1,143 @@
+// Package problem renders errors.Error as RFC 7807 problem details.
+//
+// See: https://www.rfc-editor.org/rfc/rfc7807
+package problem
+
+import (
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/httperr"
+)
+
+// ContentType of a problem details document.
+const ContentType = "application/problem+json"
+
+// Default type URI when a Cause does not provide one.
+const defaultType = "about:blank"
+
+var (
+	// ErrNotProblem is returned when decoding a response that is not a problem details document.
+	ErrNotProblem = goerrors.New("response is not " + ContentType)
+
+	// ErrUnknownType is returned when a problem type does not match any Cause.
+	ErrUnknownType = goerrors.New("unknown problem type")
+)
+
+// Details of a problem.
+type Details struct {
+	// Type URI that identifies the problem type.
+	Type string `json:"type,omitempty"`
+	// Title is a short, human-readable summary of the problem type.
+	Title string `json:"title,omitempty"`
+	// Status code generated by the origin server.
+	Status int `json:"status,omitempty"`
+	// Detail is a human-readable explanation specific to this occurrence of the problem.
+	Detail string `json:"detail,omitempty"`
+	// Instance URI that identifies the specific occurrence of the problem.
+	Instance string `json:"instance,omitempty"`
+}
+
+// Describer provides the problem details of a Cause.
+//
+// Causer types may optionally implement Describer to control how their Errors are rendered.
+// Instance is always overwritten when writing a response.
+type Describer interface {
+	Problem() Details
+}
+
+// Decodable Causer types can be restored from problem details.
+//
+// Values() is generated by cmd/errorgen. Implementing Describer is optional.
+type Decodable[T errors.Causer] interface {
+	errors.Causer
+	Values() []T
+}
+
+// New problem details for an Error.
+//
+// If the Cause does not implement Describer, the problem has type "about:blank",
+// the Error() string as the title, and the status from httperr.Status().
+func New[T errors.Causer](err errors.Error[T]) Details {
+	var details Details
+	if describer, ok := any(err.Cause).(Describer); ok {
+		details = describer.Problem()
+	}
+
+	if details.Type == "" {
+		details.Type = defaultType
+	}
+
+	if details.Title == "" {
+		details.Title = err.Error()
+	}
+
+	if details.Status == 0 {
+		details.Status = httperr.Status(err)
+	}
+
+	return details
+}
+
+// Write the Error as a problem details response.
+func Write[T errors.Causer](w http.ResponseWriter, err errors.Error[T], instance string) {
+	details := New(err)
+	details.Instance = instance
+
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(details.Status)
+
+	//nolint:errcheck,errchkjson // reason: the status has been written, there is no way to report failure
+	json.NewEncoder(w).Encode(details)
+}
+
+// Decode problem details into an Error.
+//
+// The problem type is matched against the type of each Cause in Values(), with the same defaults
+// as New(). Since "about:blank" is shared by every Cause without a type, the title must also match.
+func Decode[T Decodable[T]](reader io.Reader) (errors.Error[T], Details, error) {
+	var details Details
+	if err := json.NewDecoder(reader).Decode(&details); err != nil {
+		return errors.Ok[T](), details, fmt.Errorf("failed to decode problem: %w", err)
+	}
+
+	if details.Type == "" {
+		details.Type = defaultType
+	}
+
+	var zero T
+	for _, cause := range zero.Values() {
+		candidate := New(errors.New(cause))
+		if candidate.Type != details.Type {
+			continue
+		}
+
+		if candidate.Type != defaultType || candidate.Title == details.Title {
+			return errors.New(cause), details, nil
+		}
+	}
+
+	return errors.Ok[T](), details, fmt.Errorf("%w: %s", ErrUnknownType, details.Type)
+}
+
+// FromResponse decodes the problem details of a response into an Error.
+//
+// Responses with a 2xx status are Ok.
+// Failed responses must have a problem details body or ErrNotProblem is returned.
+func FromResponse[T Decodable[T]](response *http.Response) (errors.Error[T], Details, error) {
+	if response.StatusCode >= http.StatusOK && response.StatusCode < http.StatusMultipleChoices {
+		return errors.Ok[T](), Details{}, nil
+	}
+
+	mediaType, _, err := mime.ParseMediaType(response.Header.Get("Content-Type"))
+	if err != nil || mediaType != ContentType {
+		return errors.Ok[T](), Details{}, ErrNotProblem
+	}
+
+	return Decode[T](response.Body)
+}
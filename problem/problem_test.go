@@ -0,0 +1,254 @@
+package problem_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/problem"
+)
+
+type TestError uint
+
+const (
+	TestErrorNotFound = TestError(iota + 1)
+	TestErrorInternalFailure
+)
+
+func (self TestError) Problem() problem.Details {
+	switch self {
+	case TestErrorNotFound:
+		return problem.Details{
+			Type:   "https://example.com/problems/not-found",
+			Title:  "Not found",
+			Status: http.StatusNotFound,
+		}
+	case TestErrorInternalFailure:
+		return problem.Details{
+			Type:   "https://example.com/problems/internal-failure",
+			Title:  "Internal failure",
+			Detail: "Something went wrong",
+		}
+	}
+
+	return problem.Details{}
+}
+
+func (self TestError) Values() []TestError {
+	return []TestError{
+		TestErrorNotFound,
+		TestErrorInternalFailure,
+	}
+}
+
+type NoDescriberError uint
+
+const (
+	NoDescriberErrorFailure = NoDescriberError(iota + 1)
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	details := problem.New(errors.New(TestErrorNotFound))
+	assert.Equal(t, problem.Details{
+		Type:   "https://example.com/problems/not-found",
+		Title:  "Not found",
+		Status: http.StatusNotFound,
+	}, details)
+
+	details = problem.New(errors.New(TestErrorInternalFailure))
+	assert.Equal(t, problem.Details{
+		Type:   "https://example.com/problems/internal-failure",
+		Title:  "Internal failure",
+		Status: http.StatusInternalServerError,
+		Detail: "Something went wrong",
+	}, details)
+}
+
+func TestNewNoDescriber(t *testing.T) {
+	t.Parallel()
+
+	details := problem.New(errors.New(NoDescriberErrorFailure))
+	assert.Equal(t, problem.Details{
+		Type:   "about:blank",
+		Title:  "problem_test.NoDescriberError(1)",
+		Status: http.StatusInternalServerError,
+	}, details)
+}
+
+type StatusError uint
+
+const (
+	StatusErrorConflict = StatusError(iota + 1)
+)
+
+func (self StatusError) HTTPStatus() int {
+	return http.StatusConflict
+}
+
+func TestNewHTTPStatus(t *testing.T) {
+	t.Parallel()
+
+	details := problem.New(errors.New(StatusErrorConflict))
+	assert.Equal(t, http.StatusConflict, details.Status)
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	recorder := httptest.NewRecorder()
+	problem.Write(recorder, errors.New(TestErrorNotFound), "/widgets/1")
+
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+	assert.Equal(t, problem.ContentType, recorder.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{
+		"type": "https://example.com/problems/not-found",
+		"title": "Not found",
+		"status": 404,
+		"instance": "/widgets/1"
+	}`, recorder.Body.String())
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ok" {
+			w.WriteHeader(http.StatusOK)
+
+			return
+		}
+
+		problem.Write(w, errors.New(TestErrorInternalFailure), r.URL.Path)
+	}))
+	defer server.Close()
+
+	response, err := server.Client().Get(server.URL + "/widgets/1")
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	decoded, details, err := problem.FromResponse[TestError](response)
+	require.NoError(t, err)
+	assert.Equal(t, errors.New(TestErrorInternalFailure), decoded)
+	assert.Equal(t, "/widgets/1", details.Instance)
+	assert.Equal(t, "Something went wrong", details.Detail)
+
+	okResponse, err := server.Client().Get(server.URL + "/ok")
+	require.NoError(t, err)
+	defer okResponse.Body.Close()
+
+	decoded, _, err = problem.FromResponse[TestError](okResponse)
+	require.NoError(t, err)
+	assert.True(t, decoded.IsOk())
+}
+
+type BlankError uint
+
+const (
+	BlankErrorNotFound = BlankError(iota + 1)
+	BlankErrorConflict
+)
+
+func (self BlankError) Problem() problem.Details {
+	switch self {
+	case BlankErrorNotFound:
+		return problem.Details{Title: "Not found", Status: http.StatusNotFound}
+	case BlankErrorConflict:
+		return problem.Details{Title: "Conflict", Status: http.StatusConflict}
+	}
+
+	return problem.Details{}
+}
+
+func (self BlankError) Values() []BlankError {
+	return []BlankError{
+		BlankErrorNotFound,
+		BlankErrorConflict,
+	}
+}
+
+func TestRoundTripDefaultType(t *testing.T) {
+	t.Parallel()
+
+	for _, cause := range []BlankError{BlankErrorNotFound, BlankErrorConflict} {
+		recorder := httptest.NewRecorder()
+		problem.Write(recorder, errors.New(cause), "/widgets/1")
+
+		decoded, details, err := problem.FromResponse[BlankError](recorder.Result())
+		require.NoError(t, err)
+		assert.Equal(t, errors.New(cause), decoded)
+		assert.Equal(t, "about:blank", details.Type)
+	}
+}
+
+type StringError uint
+
+const (
+	StringErrorNotFound = StringError(iota + 1)
+	StringErrorConflict
+)
+
+func (self StringError) String() string {
+	switch self {
+	case StringErrorNotFound:
+		return "NotFound"
+	case StringErrorConflict:
+		return "Conflict"
+	}
+
+	return "Ok"
+}
+
+func (self StringError) Values() []StringError {
+	return []StringError{
+		StringErrorNotFound,
+		StringErrorConflict,
+	}
+}
+
+func TestRoundTripNoDescriber(t *testing.T) {
+	t.Parallel()
+
+	for _, cause := range []StringError{StringErrorNotFound, StringErrorConflict} {
+		recorder := httptest.NewRecorder()
+		problem.Write(recorder, errors.New(cause), "/widgets/1")
+
+		decoded, details, err := problem.FromResponse[StringError](recorder.Result())
+		require.NoError(t, err)
+		assert.Equal(t, errors.New(cause), decoded)
+		assert.Equal(t, cause.String(), details.Title)
+	}
+}
+
+func TestFromResponseNotProblem(t *testing.T) {
+	t.Parallel()
+
+	recorder := httptest.NewRecorder()
+	recorder.Header().Set("Content-Type", "text/plain")
+	recorder.WriteHeader(http.StatusBadGateway)
+
+	_, _, err := problem.FromResponse[TestError](recorder.Result())
+	assert.ErrorIs(t, err, problem.ErrNotProblem)
+}
+
+func TestDecodeUnknownType(t *testing.T) {
+	t.Parallel()
+
+	decoded, details, err := problem.Decode[TestError](strings.NewReader(`{"type":"https://example.com/problems/other","status":418}`))
+	assert.ErrorIs(t, err, problem.ErrUnknownType)
+	assert.True(t, decoded.IsOk())
+	assert.Equal(t, http.StatusTeapot, details.Status)
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := problem.Decode[TestError](strings.NewReader(`{`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,175 @@
+package errors
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+// Severity of a Cause.
+type Severity uint8
+
+const (
+	SeverityUnspecified = Severity(iota)
+	SeverityInfo
+	SeverityWarning
+	SeverityError
+	SeverityCritical
+)
+
+func (self Severity) String() string {
+	switch self {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	case SeverityCritical:
+		return "critical"
+	case SeverityUnspecified:
+	}
+
+	return "unspecified"
+}
+
+// CauseInfo is the metadata of a registered Cause.
+type CauseInfo struct {
+	// Code is a stable identifier of the Cause used on the wire.
+	// Unlike the numeric value, it does not change when the enum is reordered.
+	Code string
+	// Message is a human readable description of the Cause.
+	Message string
+	// Severity of the Cause.
+	Severity Severity
+	// Retryable is true if the operation may succeed when retried.
+	Retryable bool
+	// Public is true if the Cause may be exposed to external consumers.
+	// Otherwise, the Cause is internal.
+	Public bool
+	// DocsURL links to documentation of the Cause.
+	DocsURL string
+}
+
+// causeCatalog of a single Cause type.
+type causeCatalog struct {
+	byCause map[uint64]CauseInfo
+	byCode  map[string]uint64
+}
+
+//nolint:gochecknoglobals // reason: global registry of cause metadata
+var (
+	registry      sync.Map // map[reflect.Type]*causeCatalog
+	registerMutex sync.Mutex
+)
+
+// Register metadata for the causes of T.
+//
+// Registration is intended to happen during package initialization. Registering the same
+// Cause again replaces its metadata.
+//
+// Panics if a Cause is zero (Ok) or if a Code is used by more than one Cause of T.
+func Register[T Causer](meta map[T]CauseInfo) {
+	causeType := reflect.TypeFor[T]()
+
+	registerMutex.Lock()
+	defer registerMutex.Unlock()
+
+	catalog := &causeCatalog{
+		byCause: map[uint64]CauseInfo{},
+		byCode:  map[string]uint64{},
+	}
+
+	if existing, ok := registry.Load(causeType); ok {
+		for cause, info := range existing.(*causeCatalog).byCause {
+			catalog.byCause[cause] = info
+		}
+	}
+
+	for cause, info := range meta {
+		if cause == 0 {
+			panic(fmt.Sprintf("errors: cannot register Ok for %s", causeType))
+		}
+
+		catalog.byCause[uint64(cause)] = info
+	}
+
+	for cause, info := range catalog.byCause {
+		if info.Code == "" {
+			continue
+		}
+
+		if other, ok := catalog.byCode[info.Code]; ok && other != cause {
+			panic(fmt.Sprintf("errors: duplicate code %q for %s(%d) and %s(%d)", info.Code, causeType, other, causeType, cause))
+		}
+
+		catalog.byCode[info.Code] = cause
+	}
+
+	registry.Store(causeType, catalog)
+}
+
+// Lookup the registered metadata of a Cause.
+func Lookup[T Causer](cause T) (CauseInfo, bool) {
+	catalog, ok := catalogOf[T]()
+	if !ok {
+		return CauseInfo{}, false
+	}
+
+	info, ok := catalog.byCause[uint64(cause)]
+
+	return info, ok
+}
+
+// Info is the registered metadata of the Cause.
+//
+// See: Register()
+func (self Error[T]) Info() (CauseInfo, bool) {
+	return Lookup(self.Cause)
+}
+
+func catalogOf[T Causer]() (*causeCatalog, bool) {
+	return catalogOfType(reflect.TypeFor[T]())
+}
+
+func catalogOfType(causeType reflect.Type) (*causeCatalog, bool) {
+	catalog, ok := registry.Load(causeType)
+	if !ok {
+		return nil, false
+	}
+
+	//nolint:forcetypeassert // reason: registry only stores *causeCatalog
+	return catalog.(*causeCatalog), true
+}
+
+// lookupCode of a registered Cause.
+func lookupCode[T Causer](code string) (T, bool) {
+	catalog, ok := catalogOf[T]()
+	if !ok {
+		return 0, false
+	}
+
+	cause, ok := catalog.byCode[code]
+
+	return T(cause), ok
+}
+
+// Registered causes of T, sorted by value.
+func Registered[T Causer]() []T {
+	catalog, ok := catalogOf[T]()
+	if !ok {
+		return nil
+	}
+
+	causes := make([]T, 0, len(catalog.byCause))
+	for cause := range catalog.byCause {
+		causes = append(causes, T(cause))
+	}
+
+	sort.Slice(causes, func(i int, j int) bool {
+		return causes[i] < causes[j]
+	})
+
+	return causes
+}
@@ -0,0 +1,116 @@
+package errors_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wspowell/errors"
+)
+
+type RegisteredError uint
+
+const (
+	RegisteredErrorNotFound = RegisteredError(iota + 1)
+	RegisteredErrorTimeout
+	RegisteredErrorUnregistered
+)
+
+//nolint:gochecknoinits // reason: registration happens during initialization
+func init() {
+	errors.Register(map[RegisteredError]errors.CauseInfo{
+		RegisteredErrorNotFound: {
+			Code:     "not_found",
+			Message:  "Resource not found",
+			Severity: errors.SeverityInfo,
+			Public:   true,
+			DocsURL:  "https://example.com/errors/not_found",
+		},
+	})
+
+	errors.Register(map[RegisteredError]errors.CauseInfo{
+		RegisteredErrorTimeout: {
+			Code:      "timeout",
+			Severity:  errors.SeverityWarning,
+			Retryable: true,
+		},
+	})
+}
+
+func TestLookup(t *testing.T) {
+	info, ok := errors.Lookup(RegisteredErrorNotFound)
+	assert.True(t, ok)
+	assert.Equal(t, "not_found", info.Code)
+	assert.Equal(t, errors.SeverityInfo, info.Severity)
+
+	info, ok = errors.New(RegisteredErrorTimeout).Info()
+	assert.True(t, ok)
+	assert.True(t, info.Retryable)
+
+	_, ok = errors.Lookup(RegisteredErrorUnregistered)
+	assert.False(t, ok)
+
+	_, ok = errors.Lookup(TestErrorMyBad)
+	assert.False(t, ok)
+}
+
+func TestRegistered(t *testing.T) {
+	assert.Equal(t, []RegisteredError{RegisteredErrorNotFound, RegisteredErrorTimeout}, errors.Registered[RegisteredError]())
+	assert.Empty(t, errors.Registered[TestError]())
+}
+
+func TestRegisteredError(t *testing.T) {
+	assert.Equal(t, "Resource not found", errors.New(RegisteredErrorNotFound).Error())
+	assert.Equal(t, "timeout", errors.New(RegisteredErrorTimeout).Error())
+	assert.Equal(t, "errors_test.RegisteredError(3)", errors.New(RegisteredErrorUnregistered).Error())
+}
+
+func TestRegisteredJSON(t *testing.T) {
+	data, err := json.Marshal(errors.New(RegisteredErrorNotFound))
+	require.NoError(t, err)
+	assert.Equal(t, `"not_found"`, string(data))
+
+	var decoded errors.Error[RegisteredError]
+	require.NoError(t, json.Unmarshal([]byte(`"timeout"`), &decoded))
+	assert.Equal(t, errors.New(RegisteredErrorTimeout), decoded)
+
+	require.NoError(t, json.Unmarshal([]byte(`1`), &decoded))
+	assert.Equal(t, errors.New(RegisteredErrorNotFound), decoded)
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`3`), &decoded), errors.ErrUnknownCause)
+}
+
+func TestRegisteredLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	newTestLogger(&buf, false).Info("test", "err", errors.New(RegisteredErrorNotFound))
+	assert.JSONEq(t, `{"msg":"test","err":{
+		"type": "errors_test.RegisteredError",
+		"code": 1,
+		"name": "Resource not found",
+		"wire_code": "not_found",
+		"severity": "info",
+		"retryable": false,
+		"public": true,
+		"docs": "https://example.com/errors/not_found"
+	}}`, buf.String())
+}
+
+type PanicError uint
+
+func TestRegisterPanics(t *testing.T) {
+	assert.Panics(t, func() {
+		errors.Register(map[PanicError]errors.CauseInfo{
+			0: {Code: "ok"},
+		})
+	})
+
+	assert.Panics(t, func() {
+		errors.Register(map[PanicError]errors.CauseInfo{
+			1: {Code: "duplicate"},
+			2: {Code: "duplicate"},
+		})
+	})
+}
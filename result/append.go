@@ -0,0 +1,22 @@
+package result
+
+// errorAppender is implemented by errors.Error[T].
+type errorAppender interface {
+	AppendError(dst []byte) []byte
+}
+
+// AppendError appends "Ok" or the error string to dst and returns the extended buffer.
+//
+// Uses the AppendError() of the error, if implemented, to avoid allocating. The value is never appended.
+// With the errorsdebug build tag, an errors.Error[T] is too large to check for AppendError() without allocating.
+func (self Result[T, E]) AppendError(dst []byte) []byte {
+	if self.IsOk() {
+		return append(dst, "Ok"...)
+	}
+
+	if appender, ok := any(self.err).(errorAppender); ok {
+		return appender.AppendError(dst)
+	}
+
+	return append(dst, self.err.Error()...)
+}
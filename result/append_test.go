@@ -0,0 +1,39 @@
+package result_test
+
+import (
+	goerrors "errors"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+//nolint:paralleltest // reason: AllocsPerRun cannot be run in parallel
+func TestAppendError(t *testing.T) {
+	if unsafe.Sizeof(errors.Error[TestError]{}) != unsafe.Sizeof(TestError(0)) {
+		t.Skip("errors record stack traces")
+	}
+
+	buf := make([]byte, 0, 64)
+
+	okRes := result.Ok[int, errors.Error[TestError]](1)
+	assert.Equal(t, "Ok", string(okRes.AppendError(buf)))
+
+	errRes := result.Err[int](errors.New(TestErrorOne))
+	assert.Equal(t, "result_test.TestError(1)", string(errRes.AppendError(buf)))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = errRes.AppendError(buf[:0])
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestAppendErrorStdError(t *testing.T) {
+	t.Parallel()
+
+	res := result.Err[int](goerrors.New("failure"))
+	assert.Equal(t, "prefix: failure", string(res.AppendError([]byte("prefix: "))))
+}
@@ -0,0 +1,89 @@
+package result
+
+import "iter"
+
+// Indexed value along with its index in the original slice.
+type Indexed[T any] struct {
+	Index int
+	Value T
+}
+
+// All values of the results, or the first error.
+func All[T any, E Error](results []Result[T, E]) Result[[]T, E] {
+	values := make([]T, len(results))
+
+	for index, res := range results {
+		if !res.IsOk() {
+			return Err[[]T](res.err)
+		}
+
+		values[index] = res.value
+	}
+
+	return Ok[[]T, E](values)
+}
+
+// Any returns the first Ok result, otherwise the last Err result.
+//
+// Note: If results is empty, this is the zero Result, which is Ok.
+func Any[T any, E Error](results []Result[T, E]) Result[T, E] {
+	var last Result[T, E]
+
+	for _, res := range results {
+		if res.IsOk() {
+			return res
+		}
+
+		last = res
+	}
+
+	return last
+}
+
+// Partition the results into Ok values and errors along with their original indexes.
+func Partition[T any, E Error](results []Result[T, E]) ([]Indexed[T], []Indexed[E]) {
+	var values []Indexed[T]
+	var errs []Indexed[E]
+
+	for index, res := range results {
+		if res.IsOk() {
+			values = append(values, Indexed[T]{Index: index, Value: res.value})
+		} else {
+			errs = append(errs, Indexed[E]{Index: index, Value: res.err})
+		}
+	}
+
+	return values, errs
+}
+
+// Collect all values of the sequence, or the first error.
+//
+// The sequence is not consumed past the first error.
+func Collect[T any, E Error](seq iter.Seq[Result[T, E]]) Result[[]T, E] {
+	var values []T
+
+	for res := range seq {
+		if !res.IsOk() {
+			return Err[[]T](res.err)
+		}
+
+		values = append(values, res.value)
+	}
+
+	return Ok[[]T, E](values)
+}
+
+// GroupByError buckets the indexes of failed results by their error.
+//
+// Ok results are not included.
+func GroupByError[T any, E Error](results []Result[T, E]) map[E][]int {
+	groups := map[E][]int{}
+
+	for index, res := range results {
+		if !res.IsOk() {
+			groups[res.err] = append(groups[res.err], index)
+		}
+	}
+
+	return groups
+}
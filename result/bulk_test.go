@@ -0,0 +1,106 @@
+package result_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+func mixedResults() []result.Result[int, errors.Error[TestError]] {
+	return []result.Result[int, errors.Error[TestError]]{
+		result.Ok[int, errors.Error[TestError]](1),
+		result.Err[int](errors.New(TestErrorOne)),
+		result.Ok[int, errors.Error[TestError]](3),
+		result.Err[int](errors.New(TestErrorTwo)),
+		result.Err[int](errors.New(TestErrorOne)),
+	}
+}
+
+func okResults() []result.Result[int, errors.Error[TestError]] {
+	return []result.Result[int, errors.Error[TestError]]{
+		result.Ok[int, errors.Error[TestError]](1),
+		result.Ok[int, errors.Error[TestError]](2),
+	}
+}
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	res := result.All(okResults())
+	assert.True(t, res.IsOk())
+	assert.Equal(t, []int{1, 2}, res.Value())
+
+	res = result.All(mixedResults())
+	assert.False(t, res.IsOk())
+	assert.Equal(t, errors.New(TestErrorOne), res.Error())
+
+	res = result.All[int, errors.Error[TestError]](nil)
+	assert.True(t, res.IsOk())
+	assert.Empty(t, res.Value())
+}
+
+func TestAny(t *testing.T) {
+	t.Parallel()
+
+	res := result.Any(mixedResults()[1:])
+	assert.True(t, res.IsOk())
+	assert.Equal(t, 3, res.Value())
+
+	res = result.Any(mixedResults()[3:])
+	assert.False(t, res.IsOk())
+	assert.Equal(t, errors.New(TestErrorOne), res.Error())
+}
+
+func TestPartition(t *testing.T) {
+	t.Parallel()
+
+	values, errs := result.Partition(mixedResults())
+	assert.Equal(t, []result.Indexed[int]{
+		{Index: 0, Value: 1},
+		{Index: 2, Value: 3},
+	}, values)
+	assert.Equal(t, []result.Indexed[errors.Error[TestError]]{
+		{Index: 1, Value: errors.New(TestErrorOne)},
+		{Index: 3, Value: errors.New(TestErrorTwo)},
+		{Index: 4, Value: errors.New(TestErrorOne)},
+	}, errs)
+}
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	res := result.Collect(slices.Values(okResults()))
+	assert.True(t, res.IsOk())
+	assert.Equal(t, []int{1, 2}, res.Value())
+
+	consumed := 0
+	seq := func(yield func(result.Result[int, errors.Error[TestError]]) bool) {
+		for _, each := range mixedResults() {
+			consumed++
+			if !yield(each) {
+				return
+			}
+		}
+	}
+
+	res = result.Collect(seq)
+	assert.False(t, res.IsOk())
+	assert.Equal(t, errors.New(TestErrorOne), res.Error())
+	assert.Equal(t, 2, consumed)
+}
+
+func TestGroupByError(t *testing.T) {
+	t.Parallel()
+
+	groups := result.GroupByError(mixedResults())
+	assert.Equal(t, map[errors.Error[TestError]][]int{
+		errors.New(TestErrorOne): {1, 4},
+		errors.New(TestErrorTwo): {3},
+	}, groups)
+
+	assert.Empty(t, result.GroupByError(okResults()))
+}
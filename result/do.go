@@ -0,0 +1,69 @@
+package result
+
+// Scope of a Do() block.
+//
+// Go does not allow type parameters on methods, so values are bound with Bind(scope, res).
+type Scope[E Error] struct {
+	done bool
+}
+
+// scopeUnwind is the panic value used to unwind a Scope.
+type scopeUnwind[E Error] struct {
+	scope *Scope[E]
+	err   E
+}
+
+// Do runs fn and emulates early returns of Bind() within the Scope.
+//
+// Bind() returns the value of an Ok result, otherwise unwinds the Scope and Do returns the error:
+//
+//	res := result.Do(func(scope *result.Scope[errors.Error[ConfigError]]) Config {
+//		data := result.Bind(scope, read(path))
+//		config := result.Bind(scope, parse(data))
+//
+//		return config
+//	})
+//
+// Unwinding uses panic and recover, but only panics from this Scope are recovered.
+// Any other panic is re-raised.
+func Do[T any, E Error](fn func(scope *Scope[E]) T) (res Result[T, E]) {
+	scope := &Scope[E]{}
+
+	defer func() {
+		scope.done = true
+
+		if recovered := recover(); recovered != nil {
+			unwind, ok := recovered.(scopeUnwind[E])
+			if !ok || unwind.scope != scope {
+				panic(recovered)
+			}
+
+			res = Err[T](unwind.err)
+		}
+	}()
+
+	return Ok[T, E](fn(scope))
+}
+
+// Bind the value of an Ok result, otherwise unwind the Scope with the error.
+func Bind[T any, E Error](scope *Scope[E], res Result[T, E]) T {
+	if !res.IsOk() {
+		scope.Fail(res.err)
+	}
+
+	return res.value
+}
+
+// Fail unwinds the Scope with the error.
+//
+// Panics if called after Do() has returned.
+func (self *Scope[E]) Fail(err E) {
+	if self.done {
+		panic("result: scope used outside of Do()")
+	}
+
+	panic(scopeUnwind[E]{
+		scope: self,
+		err:   err,
+	})
+}
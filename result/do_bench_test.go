@@ -0,0 +1,84 @@
+package result_test
+
+import (
+	"testing"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+func manualSteps(first func() result.Result[int, errors.Error[TestError]]) result.Result[int, errors.Error[TestError]] {
+	res := first()
+	if !res.IsOk() {
+		return result.Err[int](res.Error())
+	}
+
+	res = double(res.Value())
+	if !res.IsOk() {
+		return result.Err[int](res.Error())
+	}
+
+	res = double(res.Value())
+	if !res.IsOk() {
+		return result.Err[int](res.Error())
+	}
+
+	return res
+}
+
+func doSteps(first func() result.Result[int, errors.Error[TestError]]) result.Result[int, errors.Error[TestError]] {
+	return result.Do(func(scope *testScope) int {
+		value := result.Bind(scope, first())
+		value = result.Bind(scope, double(value))
+
+		return result.Bind(scope, double(value))
+	})
+}
+
+func BenchmarkManualStepsOk(b *testing.B) {
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = manualSteps(resultOk)
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkDoOk(b *testing.B) {
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = doSteps(resultOk)
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkManualStepsErr(b *testing.B) {
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = manualSteps(resultErr)
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkDoErr(b *testing.B) {
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = doSteps(resultErr)
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
@@ -0,0 +1,106 @@
+package result_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+type testScope = result.Scope[errors.Error[TestError]]
+
+func double(value int) result.Result[int, errors.Error[TestError]] {
+	return result.Ok[int, errors.Error[TestError]](value * 2)
+}
+
+func TestDoOk(t *testing.T) {
+	t.Parallel()
+
+	res := result.Do(func(scope *testScope) int {
+		first := result.Bind(scope, resultOk())
+		second := result.Bind(scope, double(first))
+
+		return result.Bind(scope, double(second))
+	})
+
+	assert.True(t, res.IsOk())
+	assert.Equal(t, 4, res.Value())
+}
+
+func TestDoErr(t *testing.T) {
+	t.Parallel()
+
+	reached := false
+	res := result.Do(func(scope *testScope) int {
+		first := result.Bind(scope, resultOk())
+		second := result.Bind(scope, resultErr())
+		reached = true
+
+		return first + second
+	})
+
+	assert.False(t, res.IsOk())
+	assert.Equal(t, errors.New(TestErrorOne), res.Error())
+	assert.False(t, reached)
+}
+
+func TestDoFail(t *testing.T) {
+	t.Parallel()
+
+	res := result.Do(func(scope *testScope) int {
+		scope.Fail(errors.New(TestErrorTwo))
+
+		return 1
+	})
+
+	assert.Equal(t, errors.New(TestErrorTwo), res.Error())
+}
+
+func TestDoNested(t *testing.T) {
+	t.Parallel()
+
+	res := result.Do(func(outer *testScope) int {
+		inner := result.Do(func(inner *testScope) int {
+			return result.Bind(inner, resultErr())
+		})
+
+		assert.Equal(t, errors.New(TestErrorOne), inner.Error())
+
+		result.Do(func(inner *testScope) int {
+			outer.Fail(errors.New(TestErrorTwo))
+
+			return 0
+		})
+
+		return 1
+	})
+
+	assert.Equal(t, errors.New(TestErrorTwo), res.Error())
+}
+
+func TestDoOtherPanic(t *testing.T) {
+	t.Parallel()
+
+	assert.PanicsWithValue(t, "other", func() {
+		result.Do(func(scope *testScope) int {
+			panic("other")
+		})
+	})
+}
+
+func TestDoEscapedScope(t *testing.T) {
+	t.Parallel()
+
+	var escaped *testScope
+	result.Do(func(scope *testScope) int {
+		escaped = scope
+
+		return 0
+	})
+
+	assert.PanicsWithValue(t, "result: scope used outside of Do()", func() {
+		result.Bind(escaped, resultErr())
+	})
+}
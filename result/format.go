@@ -0,0 +1,83 @@
+package result
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Format the Result.
+//
+// Satisfies fmt.Formatter.
+//
+//	%v, %s  Ok(value) or Err(message)
+//	%+v     Ok(value) or Err(message), with both formatted using %+v
+//	%#v     Go syntax, such as result.Err[int](errors.New(pkg.ExampleErrorFailure))
+//
+// Any other verb, such as %d or %q, formats the value of an Ok result, otherwise the error.
+func (self Result[T, E]) Format(state fmt.State, verb rune) {
+	switch verb {
+	case 'v', 's':
+		if verb == 'v' && state.Flag('#') {
+			self.formatGoSyntax(state)
+
+			return
+		}
+
+		directive := "%v"
+		if verb == 'v' && state.Flag('+') {
+			directive = "%+v"
+		}
+
+		if self.IsOk() {
+			fmt.Fprintf(state, "Ok("+directive+")", self.value)
+		} else {
+			fmt.Fprintf(state, "Err("+directive+")", self.err)
+		}
+	default:
+		if self.IsOk() {
+			fmt.Fprintf(state, fmt.FormatString(state, verb), self.value)
+		} else {
+			fmt.Fprintf(state, fmt.FormatString(state, verb), self.err)
+		}
+	}
+}
+
+func (self Result[T, E]) formatGoSyntax(state fmt.State) {
+	if self.IsOk() {
+		fmt.Fprintf(state, "result.Ok[%s, %s](%#v)", goTypeName[T](), goTypeName[E](), self.value)
+	} else {
+		fmt.Fprintf(state, "result.Err[%s](%#v)", goTypeName[T](), self.err)
+	}
+}
+
+// goTypeName of T as written in Go source.
+//
+// reflect qualifies the type arguments of generic types with their full import path, which is trimmed to the package name.
+func goTypeName[T any]() string {
+	name := reflect.TypeFor[T]().String()
+
+	var builder strings.Builder
+
+	start := 0
+	for index := 0; index <= len(name); index++ {
+		if index < len(name) && !strings.ContainsRune("[](), *", rune(name[index])) {
+			continue
+		}
+
+		token := name[start:index]
+		if slash := strings.LastIndexByte(token, '/'); slash != -1 {
+			token = token[slash+1:]
+		}
+
+		builder.WriteString(token)
+
+		if index < len(name) {
+			builder.WriteByte(name[index])
+		}
+
+		start = index + 1
+	}
+
+	return builder.String()
+}
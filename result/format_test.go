@@ -0,0 +1,39 @@
+package result_test
+
+import (
+	goerrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	okRes := result.Ok[int, errors.Error[TestError]](7)
+	assert.Equal(t, "Ok(7)", fmt.Sprintf("%v", okRes))
+	assert.Equal(t, "Ok(7)", fmt.Sprintf("%s", okRes))
+	assert.Equal(t, "Ok(7)", fmt.Sprintf("%+v", okRes))
+	assert.Equal(t, "007", fmt.Sprintf("%03d", okRes))
+	assert.Equal(t, "result.Ok[int, errors.Error[result_test.TestError]](7)", fmt.Sprintf("%#v", okRes))
+
+	errRes := result.Err[int](errors.New(TestErrorOne))
+	assert.Equal(t, "Err(result_test.TestError(1))", fmt.Sprintf("%v", errRes))
+	assert.Equal(t, "Err(result_test.TestError(1))", fmt.Sprintf("%s", errRes))
+	assert.Equal(t, "Err(result_test.TestError(1) (result_test.TestError=1))", fmt.Sprintf("%+v", errRes))
+	assert.Equal(t, "1", fmt.Sprintf("%d", errRes))
+	assert.Equal(t, `"result_test.TestError(1)"`, fmt.Sprintf("%q", errRes))
+	assert.Equal(t, "result.Err[int](errors.New(result_test.TestError(1)))", fmt.Sprintf("%#v", errRes))
+}
+
+func TestFormatStdError(t *testing.T) {
+	t.Parallel()
+
+	res := result.Err[[]string](goerrors.New("failure"))
+	assert.Equal(t, "Err(failure)", fmt.Sprintf("%v", res))
+	assert.Equal(t, `result.Err[[]string](&errors.errorString{s:"failure"})`, fmt.Sprintf("%#v", res))
+}
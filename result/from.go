@@ -0,0 +1,54 @@
+package result
+
+import (
+	"github.com/wspowell/errors"
+)
+
+// From lifts a (value, error) pair into a Result.
+//
+// A nil err is an Ok result of value. Otherwise, this is an Err result of err.
+//
+//	res := result.From(os.ReadFile(path))
+func From[T any](value T, err error) Result[T, error] {
+	if err != nil {
+		return Err[T](err)
+	}
+
+	return Ok[T, error](value)
+}
+
+// FromFunc calls fn and lifts its (value, error) return into a Result.
+func FromFunc[T any](fn func() (T, error)) Result[T, error] {
+	return From(fn())
+}
+
+// Classify the error of a Result into a typed Cause.
+//
+// The classifier is typically created with errors.Classify().
+func Classify[T any, C errors.Causer](self Result[T, error], classify func(error) errors.Error[C]) Result[T, errors.Error[C]] {
+	if self.IsOk() {
+		return Ok[T, errors.Error[C]](self.value)
+	}
+
+	return Err[T](classify(self.err))
+}
+
+// Of creates a function that lifts a (value, error) pair into a Result with a typed Cause.
+//
+// Go does not allow passing a multi-value return alongside other arguments, so the classifier is given first:
+//
+//	res := result.Of[[]byte](classify)(os.ReadFile(path))
+func Of[T any, C errors.Causer](classify func(error) errors.Error[C]) func(T, error) Result[T, errors.Error[C]] {
+	return func(value T, err error) Result[T, errors.Error[C]] {
+		if err != nil {
+			return Err[T](classify(err))
+		}
+
+		return Ok[T, errors.Error[C]](value)
+	}
+}
+
+// OfFunc calls fn and lifts its (value, error) return into a Result with a typed Cause.
+func OfFunc[T any, C errors.Causer](fn func() (T, error), classify func(error) errors.Error[C]) Result[T, errors.Error[C]] {
+	return Of[T](classify)(fn())
+}
@@ -0,0 +1,85 @@
+package result_test
+
+import (
+	goerrors "errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+//nolint:gochecknoglobals // reason: shared test classifier
+var classifyTestError = errors.Classify(TestErrorTwo,
+	errors.MatchIs(fs.ErrNotExist, TestErrorOne),
+)
+
+func TestFrom(t *testing.T) {
+	t.Parallel()
+
+	res := result.From(strconv.Atoi("1"))
+	assert.True(t, res.IsOk())
+	assert.Equal(t, 1, res.Value())
+
+	res = result.From(strconv.Atoi("a"))
+	assert.False(t, res.IsOk())
+
+	var numErr *strconv.NumError
+	assert.True(t, goerrors.As(res.Error(), &numErr))
+}
+
+func TestFromFunc(t *testing.T) {
+	t.Parallel()
+
+	res := result.FromFunc(func() (int, error) {
+		return strconv.Atoi("2")
+	})
+	assert.True(t, res.IsOk())
+	assert.Equal(t, 2, res.Value())
+}
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+
+	res := result.Classify(result.From(os.ReadFile("does-not-exist")), classifyTestError)
+	assert.False(t, res.IsOk())
+	assert.Equal(t, errors.New(TestErrorOne), res.Error())
+
+	okRes := result.Classify(result.From(strconv.Atoi("1")), classifyTestError)
+	assert.True(t, okRes.IsOk())
+	assert.Equal(t, 1, okRes.Value())
+}
+
+func TestOf(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0o600))
+
+	res := result.Of[[]byte](classifyTestError)(os.ReadFile(path))
+	assert.True(t, res.IsOk())
+	assert.Equal(t, []byte("data"), res.Value())
+
+	res = result.Of[[]byte](classifyTestError)(os.ReadFile(filepath.Join(t.TempDir(), "missing")))
+	assert.False(t, res.IsOk())
+	assert.Equal(t, errors.New(TestErrorOne), res.Error())
+
+	atoi := result.Of[int](classifyTestError)
+	assert.Equal(t, errors.New(TestErrorTwo), atoi(strconv.Atoi("a")).Error())
+}
+
+func TestOfFunc(t *testing.T) {
+	t.Parallel()
+
+	res := result.OfFunc(func() (int, error) {
+		return strconv.Atoi("a")
+	}, classifyTestError)
+	assert.False(t, res.IsOk())
+	assert.Equal(t, errors.New(TestErrorTwo), res.Error())
+}
@@ -0,0 +1,178 @@
+package result
+
+import (
+	"context"
+)
+
+// Future result of an operation running in its own goroutine.
+type Future[T any, E Error] struct {
+	done      chan struct{}
+	cancel    context.CancelFunc
+	cancelErr E
+	result    Result[T, E]
+}
+
+// Go runs fn in a new goroutine and returns a Future of its Result.
+//
+// The context passed to fn is cancelled when ctx is cancelled, when Future.Cancel() is called,
+// or when Future.Await() gives up waiting.
+//
+// If fn panics with a *PanicError[E], the Future is an Err result of its error.
+// If fn otherwise panics, the Future is an Err result of panicErr. If panicErr is the zero value (Ok),
+// the panic is not recovered.
+//
+// When Future.Await() gives up waiting, it returns an Err result of cancelErr without waiting for fn
+// to return. If cancelErr is the zero value (Ok), Future.Await() waits for fn to return its own Result,
+// which never happens if fn ignores its context.
+func Go[T any, E Error](ctx context.Context, fn func(context.Context) Result[T, E], panicErr E, cancelErr E) *Future[T, E] {
+	ctx, cancel := context.WithCancel(ctx)
+
+	future := &Future[T, E]{
+		done:      make(chan struct{}),
+		cancel:    cancel,
+		cancelErr: cancelErr,
+	}
+
+	go func() {
+		defer close(future.done)
+		defer cancel()
+
+		var ok E
+		if panicErr != ok {
+			defer func() {
+				if recovered := recover(); recovered != nil {
+					if resultPanic, ok := recovered.(*PanicError[E]); ok {
+						future.result = Err[T](resultPanic.Err)
+					} else {
+						future.result = Err[T](panicErr)
+					}
+				}
+			}()
+		}
+
+		future.result = fn(ctx)
+	}()
+
+	return future
+}
+
+// Done is closed once the Result is available.
+func (self *Future[T, E]) Done() <-chan struct{} {
+	return self.done
+}
+
+// Cancel the context of the running function.
+//
+// The Future still completes with whatever Result the function returns.
+func (self *Future[T, E]) Cancel() {
+	self.cancel()
+}
+
+// Await the Result.
+//
+// If ctx is done first, the Future is cancelled and Await returns an Err result of the cancelErr given
+// to Go(). The function keeps running until it observes its context, and a later Await returns its Result.
+// A Future that has already completed always returns its Result, even if ctx is done.
+func (self *Future[T, E]) Await(ctx context.Context) Result[T, E] {
+	select {
+	case <-self.done:
+		return self.result
+	case <-ctx.Done():
+		// select picks randomly when both are ready.
+		select {
+		case <-self.done:
+			return self.result
+		default:
+		}
+
+		self.cancel()
+
+		return self.cancelled()
+	}
+}
+
+// cancelled Result once waiting has been given up.
+func (self *Future[T, E]) cancelled() Result[T, E] {
+	var ok E
+	if self.cancelErr != ok {
+		return Err[T](self.cancelErr)
+	}
+
+	<-self.done
+
+	return self.result
+}
+
+// AwaitAll values of the futures in order, or the first error in order.
+//
+// Upon the first error, the remaining futures are cancelled.
+func AwaitAll[T any, E Error](ctx context.Context, futures ...*Future[T, E]) Result[[]T, E] {
+	values := make([]T, len(futures))
+
+	for index, future := range futures {
+		res := future.Await(ctx)
+		if !res.IsOk() {
+			for _, remaining := range futures[index+1:] {
+				remaining.Cancel()
+			}
+
+			return Err[[]T](res.err)
+		}
+
+		values[index] = res.value
+	}
+
+	return Ok[[]T, E](values)
+}
+
+// Race the futures and return the Result of the first to complete.
+//
+// The remaining futures are cancelled. If ctx is done first, all futures are cancelled and the
+// Result is an Err of the cancelErr of the first future (see Future.Await()), unless a future has
+// already completed.
+//
+// Note: If futures is empty, this is the zero Result, which is Ok.
+func Race[T any, E Error](ctx context.Context, futures ...*Future[T, E]) Result[T, E] {
+	if len(futures) == 0 {
+		return Result[T, E]{}
+	}
+
+	winner := make(chan *Future[T, E], len(futures))
+	for _, future := range futures {
+		go func(future *Future[T, E]) {
+			<-future.done
+			winner <- future
+		}(future)
+	}
+
+	defer func() {
+		for _, future := range futures {
+			future.Cancel()
+		}
+	}()
+
+	select {
+	case first := <-winner:
+		return first.result
+	case <-ctx.Done():
+		// A future may have completed before its result was sent to winner.
+		for _, future := range futures {
+			select {
+			case <-future.done:
+				return future.result
+			default:
+			}
+		}
+
+		var ok E
+		if futures[0].cancelErr != ok {
+			return Err[T](futures[0].cancelErr)
+		}
+
+		for _, future := range futures {
+			future.Cancel()
+		}
+
+		return (<-winner).result
+	}
+}
@@ -0,0 +1,229 @@
+package result_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+const (
+	TestErrorCancelled = TestError(iota + 10)
+	TestErrorPanic
+	TestErrorGaveUp
+)
+
+func waitForCancel(ctx context.Context) result.Result[int, errors.Error[TestError]] {
+	<-ctx.Done()
+
+	return result.Err[int](errors.New(TestErrorCancelled))
+}
+
+func okAfter(value int, delay time.Duration) func(context.Context) result.Result[int, errors.Error[TestError]] {
+	return func(ctx context.Context) result.Result[int, errors.Error[TestError]] {
+		select {
+		case <-time.After(delay):
+			return result.Ok[int, errors.Error[TestError]](value)
+		case <-ctx.Done():
+			return result.Err[int](errors.New(TestErrorCancelled))
+		}
+	}
+}
+
+func TestFutureOk(t *testing.T) {
+	t.Parallel()
+
+	future := result.Go(context.Background(), okAfter(1, 0), errors.New(TestErrorPanic), errors.New(TestErrorGaveUp))
+
+	<-future.Done()
+	res := future.Await(context.Background())
+	assert.True(t, res.IsOk())
+	assert.Equal(t, 1, res.Value())
+}
+
+func TestFuturePanic(t *testing.T) {
+	t.Parallel()
+
+	future := result.Go(context.Background(), func(ctx context.Context) result.Result[int, errors.Error[TestError]] {
+		panic("failure")
+	}, errors.New(TestErrorPanic), errors.New(TestErrorGaveUp))
+
+	res := future.Await(context.Background())
+	assert.False(t, res.IsOk())
+	assert.Equal(t, errors.New(TestErrorPanic), res.Error())
+}
+
+func TestFuturePanicError(t *testing.T) {
+	t.Parallel()
+
+	future := result.Go(context.Background(), func(ctx context.Context) result.Result[int, errors.Error[TestError]] {
+		return result.Ok[int, errors.Error[TestError]](result.Err[int](errors.New(TestErrorTwo)).ValueOrPanic())
+	}, errors.New(TestErrorPanic), errors.New(TestErrorGaveUp))
+
+	res := future.Await(context.Background())
+	assert.Equal(t, errors.New(TestErrorTwo), res.Error())
+}
+
+func TestFutureCancel(t *testing.T) {
+	t.Parallel()
+
+	future := result.Go(context.Background(), waitForCancel, errors.New(TestErrorPanic), errors.New(TestErrorGaveUp))
+	future.Cancel()
+
+	res := future.Await(context.Background())
+	assert.Equal(t, errors.New(TestErrorCancelled), res.Error())
+}
+
+func TestFutureParentCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	future := result.Go(ctx, waitForCancel, errors.New(TestErrorPanic), errors.New(TestErrorGaveUp))
+	cancel()
+
+	res := future.Await(context.Background())
+	assert.Equal(t, errors.New(TestErrorCancelled), res.Error())
+}
+
+func TestFutureAwaitCancel(t *testing.T) {
+	t.Parallel()
+
+	future := result.Go(context.Background(), waitForCancel, errors.New(TestErrorPanic), errors.Ok[TestError]())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	res := future.Await(ctx)
+	assert.Equal(t, errors.New(TestErrorCancelled), res.Error())
+}
+
+func TestFutureAwaitCancelIgnored(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+	defer close(release)
+
+	future := result.Go(context.Background(), func(ctx context.Context) result.Result[int, errors.Error[TestError]] {
+		<-release
+
+		return result.Ok[int, errors.Error[TestError]](1)
+	}, errors.New(TestErrorPanic), errors.New(TestErrorGaveUp))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	res := future.Await(ctx)
+	assert.Equal(t, errors.New(TestErrorGaveUp), res.Error())
+
+	select {
+	case <-future.Done():
+		assert.Fail(t, "function ignoring its context should still be running")
+	default:
+	}
+}
+
+func TestFutureAwaitCompletedCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	future := result.Go(context.Background(), okAfter(1, 0), errors.New(TestErrorPanic), errors.New(TestErrorGaveUp))
+	<-future.Done()
+
+	// Repeated since select picks randomly when both are ready.
+	for range 100 {
+		res := future.Await(ctx)
+		assert.True(t, res.IsOk())
+		assert.Equal(t, 1, res.Value())
+	}
+}
+
+func TestAwaitAll(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	panicErr := errors.New(TestErrorPanic)
+	gaveUpErr := errors.New(TestErrorGaveUp)
+
+	res := result.AwaitAll(ctx,
+		result.Go(ctx, okAfter(1, time.Millisecond), panicErr, gaveUpErr),
+		result.Go(ctx, okAfter(2, 0), panicErr, gaveUpErr),
+	)
+	assert.True(t, res.IsOk())
+	assert.Equal(t, []int{1, 2}, res.Value())
+
+	pending := result.Go(ctx, waitForCancel, panicErr, gaveUpErr)
+	res = result.AwaitAll(ctx,
+		result.Go(ctx, func(ctx context.Context) result.Result[int, errors.Error[TestError]] {
+			return result.Err[int](errors.New(TestErrorOne))
+		}, panicErr, gaveUpErr),
+		pending,
+	)
+	assert.Equal(t, errors.New(TestErrorOne), res.Error())
+	assert.Equal(t, errors.New(TestErrorCancelled), pending.Await(ctx).Error())
+}
+
+func TestRace(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	panicErr := errors.New(TestErrorPanic)
+	gaveUpErr := errors.New(TestErrorGaveUp)
+
+	slow := result.Go(ctx, waitForCancel, panicErr, gaveUpErr)
+	res := result.Race(ctx,
+		slow,
+		result.Go(ctx, okAfter(2, 0), panicErr, gaveUpErr),
+	)
+	assert.True(t, res.IsOk())
+	assert.Equal(t, 2, res.Value())
+	assert.Equal(t, errors.New(TestErrorCancelled), slow.Await(ctx).Error())
+
+	assert.True(t, result.Race[int, errors.Error[TestError]](ctx).IsOk())
+}
+
+func TestRaceCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	release := make(chan struct{})
+	defer close(release)
+
+	res := result.Race(ctx,
+		result.Go(context.Background(), func(ctx context.Context) result.Result[int, errors.Error[TestError]] {
+			<-release
+
+			return result.Ok[int, errors.Error[TestError]](1)
+		}, errors.New(TestErrorPanic), errors.New(TestErrorGaveUp)),
+	)
+	assert.Equal(t, errors.New(TestErrorGaveUp), res.Error())
+
+	res = result.Race(ctx,
+		result.Go(context.Background(), waitForCancel, errors.New(TestErrorPanic), errors.Ok[TestError]()),
+	)
+	assert.Equal(t, errors.New(TestErrorCancelled), res.Error())
+}
+
+func TestRaceCompletedCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	future := result.Go(context.Background(), okAfter(1, 0), errors.New(TestErrorPanic), errors.New(TestErrorGaveUp))
+	<-future.Done()
+
+	// Repeated since select picks randomly when both are ready.
+	for range 100 {
+		res := result.Race(ctx, future)
+		assert.True(t, res.IsOk())
+		assert.Equal(t, 1, res.Value())
+	}
+}
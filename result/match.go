@@ -0,0 +1,122 @@
+package result
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/wspowell/errors"
+)
+
+// Match the result by calling onOk with the value of an Ok result, otherwise onErr with the error.
+func Match[T any, E Error, U any](self Result[T, E], onOk func(T) U, onErr func(E) U) U {
+	if self.IsOk() {
+		return onOk(self.value)
+	}
+
+	return onErr(self.err)
+}
+
+// Switcher dispatches a result to the branch of its Cause.
+//
+// See: Switch()
+type Switcher[C errors.Causer, T any] struct {
+	res     Result[T, errors.Error[C]]
+	handled bool
+	cases   map[C]bool
+}
+
+// Switch on the Cause of a result.
+//
+// Branches are called as they are declared and at most one branch is called:
+//
+//	result.Switch[WidgetError](res).
+//		Ok(func(widget Widget) { ... }).
+//		Case(WidgetErrorNotFound, func(err errors.Error[WidgetError]) { ... }).
+//		Default(func(err errors.Error[WidgetError]) { ... })
+//
+// When built with the errorsdebug build tag, Default() and End() panic if any Cause from
+// Values() or errors.Registered() does not have a Case. Run tests with -tags errorsdebug
+// to verify every switch they reach.
+func Switch[C errors.Causer, T any](self Result[T, errors.Error[C]]) Switcher[C, T] {
+	switcher := Switcher[C, T]{
+		res: self,
+	}
+
+	if verifySwitch {
+		switcher.cases = map[C]bool{}
+	}
+
+	return switcher
+}
+
+// Ok branch is called with the value of an Ok result.
+func (self Switcher[C, T]) Ok(fn func(T)) Switcher[C, T] {
+	if !self.handled && self.res.IsOk() {
+		self.handled = true
+		fn(self.res.value)
+	}
+
+	return self
+}
+
+// Case branch is called with the error if the result has the given Cause.
+func (self Switcher[C, T]) Case(cause C, fn func(errors.Error[C])) Switcher[C, T] {
+	if self.cases != nil {
+		self.cases[cause] = true
+	}
+
+	if !self.handled && !self.res.IsOk() && self.res.err.Cause == cause {
+		self.handled = true
+		fn(self.res.err)
+	}
+
+	return self
+}
+
+// Default branch is called with the error if no Case matched an Err result.
+func (self Switcher[C, T]) Default(fn func(errors.Error[C])) {
+	self.End()
+
+	if !self.handled && !self.res.IsOk() {
+		fn(self.res.err)
+	}
+}
+
+// End the switch without a Default branch.
+func (self Switcher[C, T]) End() {
+	if self.cases == nil {
+		return
+	}
+
+	if missing := self.missing(); len(missing) != 0 {
+		panic(fmt.Sprintf("result: non-exhaustive switch of %T: missing cases %v", C(0), missing))
+	}
+}
+
+// missing causes that do not have a Case.
+func (self Switcher[C, T]) missing() []C {
+	known := map[C]bool{}
+
+	if values, ok := any(C(0)).(interface{ Values() []C }); ok {
+		for _, cause := range values.Values() {
+			known[cause] = true
+		}
+	}
+
+	for _, cause := range errors.Registered[C]() {
+		known[cause] = true
+	}
+
+	var missing []C
+	for cause := range known {
+		if !self.cases[cause] {
+			missing = append(missing, cause)
+		}
+	}
+
+	sort.Slice(missing, func(i int, j int) bool {
+		return missing[i] < missing[j]
+	})
+
+	return missing
+}
@@ -0,0 +1,22 @@
+//go:build !errorsdebug
+
+package result_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+func TestSwitchNotVerified(t *testing.T) {
+	t.Parallel()
+
+	assert.NotPanics(t, func() {
+		result.Switch[MatchError](result.Ok[int, errors.Error[MatchError]](1)).
+			Case(MatchErrorNotFound, func(errors.Error[MatchError]) {}).
+			End()
+	})
+}
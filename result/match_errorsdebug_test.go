@@ -0,0 +1,39 @@
+//go:build errorsdebug
+
+package result_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+func TestSwitchNotExhaustive(t *testing.T) {
+	t.Parallel()
+
+	assert.PanicsWithValue(t, "result: non-exhaustive switch of result_test.MatchError: missing cases [2]", func() {
+		result.Switch[MatchError](result.Ok[int, errors.Error[MatchError]](1)).
+			Case(MatchErrorNotFound, func(errors.Error[MatchError]) {}).
+			Default(func(errors.Error[MatchError]) {})
+	})
+}
+
+func TestSwitchRegistered(t *testing.T) {
+	t.Parallel()
+
+	assert.PanicsWithValue(t, "result: non-exhaustive switch of result_test.RegisteredMatchError: missing cases [1]", func() {
+		result.Switch[RegisteredMatchError](result.Ok[int, errors.Error[RegisteredMatchError]](1)).
+			Case(RegisteredMatchErrorTimeout, func(errors.Error[RegisteredMatchError]) {}).
+			End()
+	})
+
+	assert.NotPanics(t, func() {
+		result.Switch[RegisteredMatchError](result.Ok[int, errors.Error[RegisteredMatchError]](1)).
+			Case(RegisteredMatchErrorNotFound, func(errors.Error[RegisteredMatchError]) {}).
+			Case(RegisteredMatchErrorTimeout, func(errors.Error[RegisteredMatchError]) {}).
+			End()
+	})
+}
@@ -0,0 +1,84 @@
+package result_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+type MatchError uint
+
+const (
+	MatchErrorNotFound = MatchError(iota + 1)
+	MatchErrorTimeout
+)
+
+func (self MatchError) Values() []MatchError {
+	return []MatchError{MatchErrorNotFound, MatchErrorTimeout}
+}
+
+type RegisteredMatchError uint
+
+const (
+	RegisteredMatchErrorNotFound = RegisteredMatchError(iota + 1)
+	RegisteredMatchErrorTimeout
+)
+
+//nolint:gochecknoinits // reason: registration happens during initialization
+func init() {
+	errors.Register(map[RegisteredMatchError]errors.CauseInfo{
+		RegisteredMatchErrorNotFound: {Code: "not_found"},
+		RegisteredMatchErrorTimeout:  {Code: "timeout"},
+	})
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	describe := func(res result.Result[int, errors.Error[TestError]]) string {
+		return result.Match(res, strconv.Itoa, func(err errors.Error[TestError]) string {
+			return err.Error()
+		})
+	}
+
+	assert.Equal(t, "1", describe(result.Ok[int, errors.Error[TestError]](1)))
+	assert.Equal(t, "result_test.TestError(1)", describe(result.Err[int](errors.New(TestErrorOne))))
+}
+
+func describeMatch(res result.Result[int, errors.Error[MatchError]]) string {
+	var branch string
+
+	result.Switch[MatchError](res).
+		Ok(func(value int) { branch = "ok " + strconv.Itoa(value) }).
+		Case(MatchErrorNotFound, func(errors.Error[MatchError]) { branch = "not found" }).
+		Case(MatchErrorTimeout, func(errors.Error[MatchError]) { branch = "timeout" }).
+		Default(func(errors.Error[MatchError]) { branch = "default" })
+
+	return branch
+}
+
+func TestSwitch(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "ok 1", describeMatch(result.Ok[int, errors.Error[MatchError]](1)))
+	assert.Equal(t, "not found", describeMatch(result.Err[int](errors.New(MatchErrorNotFound))))
+	assert.Equal(t, "timeout", describeMatch(result.Err[int](errors.New(MatchErrorTimeout))))
+	assert.Equal(t, "default", describeMatch(result.Err[int](errors.New(MatchError(3)))))
+}
+
+func TestSwitchFirstCaseWins(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	result.Switch[MatchError](result.Err[int](errors.New(MatchErrorNotFound))).
+		Case(MatchErrorNotFound, func(errors.Error[MatchError]) { calls++ }).
+		Case(MatchErrorNotFound, func(errors.Error[MatchError]) { calls++ }).
+		Case(MatchErrorTimeout, func(errors.Error[MatchError]) { calls++ }).
+		End()
+
+	assert.Equal(t, 1, calls)
+}
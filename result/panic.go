@@ -0,0 +1,75 @@
+package result
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is the value of a panic caused by an Err result.
+//
+// See: Result.ValueOrPanic(), Must(), MustOk(), Recover()
+type PanicError[E Error] struct {
+	// Err of the result.
+	Err E
+	// Stack trace where the panic occurred.
+	Stack []byte
+}
+
+// Error string of the panic including the stack trace.
+func (self *PanicError[E]) Error() string {
+	return fmt.Sprintf("result panic: %s\nstack trace:\n%s\n", self.Err, string(self.Stack))
+}
+
+// Unwrap the error of the result.
+func (self *PanicError[E]) Unwrap() error {
+	return self.Err
+}
+
+// Must return the value, otherwise panic with a *PanicError[error].
+//
+// Intended to wrap (value, error) returns:
+//
+//	config := result.Must(os.ReadFile(path))
+func Must[T any](value T, err error) T {
+	if err != nil {
+		panic(&PanicError[error]{
+			Err:   err,
+			Stack: debug.Stack(),
+		})
+	}
+
+	return value
+}
+
+// MustOk return the value of the result, otherwise panic with a *PanicError[E].
+//
+// See: Result.ValueOrPanic()
+func MustOk[T any, E Error](self Result[T, E]) T {
+	return self.ValueOrPanic()
+}
+
+// Recover a *PanicError[E] into an Err result.
+//
+// Must be deferred directly at a function boundary with a named Result:
+//
+//	func load() (res result.Result[Config, errors.Error[ConfigError]]) {
+//		defer result.Recover(&res)
+//
+//		data := result.MustOk(read())
+//		return result.Ok[Config, errors.Error[ConfigError]](result.MustOk(parse(data)))
+//	}
+//
+// Any other panic is re-raised.
+func Recover[T any, E Error](res *Result[T, E]) {
+	recovered := recover()
+	if recovered == nil {
+		return
+	}
+
+	panicErr, ok := recovered.(*PanicError[E])
+	if !ok {
+		panic(recovered)
+	}
+
+	*res = Err[T](panicErr.Err)
+}
@@ -0,0 +1,102 @@
+package result_test
+
+import (
+	goerrors "errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+func recoveredPanic(fn func()) (recovered any) {
+	defer func() {
+		recovered = recover()
+	}()
+
+	fn()
+
+	return nil
+}
+
+func TestValueOrPanicError(t *testing.T) {
+	t.Parallel()
+
+	recovered := recoveredPanic(func() {
+		result.Err[int](errors.New(TestErrorOne)).ValueOrPanic()
+	})
+
+	panicErr, ok := recovered.(*result.PanicError[errors.Error[TestError]])
+	require.True(t, ok)
+	assert.Equal(t, errors.New(TestErrorOne), panicErr.Err)
+	assert.NotEmpty(t, panicErr.Stack)
+	assert.Contains(t, panicErr.Error(), "result panic: result_test.TestError(1)")
+	assert.True(t, goerrors.Is(panicErr, errors.New(TestErrorOne)))
+}
+
+func TestMust(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 1, result.Must(strconv.Atoi("1")))
+
+	recovered := recoveredPanic(func() {
+		result.Must(strconv.Atoi("a"))
+	})
+
+	panicErr, ok := recovered.(*result.PanicError[error])
+	require.True(t, ok)
+
+	var numErr *strconv.NumError
+	assert.True(t, goerrors.As(panicErr, &numErr))
+}
+
+func TestMustOk(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 1, result.MustOk(result.Ok[int, errors.Error[TestError]](1)))
+	assert.Panics(t, func() {
+		result.MustOk(result.Err[int](errors.New(TestErrorOne)))
+	})
+}
+
+func tryAdd(first result.Result[int, errors.Error[TestError]], second result.Result[int, errors.Error[TestError]]) (res result.Result[int, errors.Error[TestError]]) {
+	defer result.Recover(&res)
+
+	return result.Ok[int, errors.Error[TestError]](result.MustOk(first) + result.MustOk(second))
+}
+
+func TestRecover(t *testing.T) {
+	t.Parallel()
+
+	one := result.Ok[int, errors.Error[TestError]](1)
+	failed := result.Err[int](errors.New(TestErrorTwo))
+
+	res := tryAdd(one, one)
+	assert.True(t, res.IsOk())
+	assert.Equal(t, 2, res.Value())
+
+	res = tryAdd(one, failed)
+	assert.False(t, res.IsOk())
+	assert.Equal(t, errors.New(TestErrorTwo), res.Error())
+}
+
+func TestRecoverOtherPanic(t *testing.T) {
+	t.Parallel()
+
+	assert.PanicsWithValue(t, "other", func() {
+		var res result.Result[int, errors.Error[TestError]]
+		defer result.Recover(&res)
+
+		panic("other")
+	})
+
+	assert.Panics(t, func() {
+		var res result.Result[int, errors.Error[OtherError]]
+		defer result.Recover(&res)
+
+		result.Err[int](errors.New(TestErrorOne)).ValueOrPanic()
+	})
+}
@@ -1,7 +1,7 @@
 package result
 
 import (
-	"fmt"
+	"log/slog"
 	"runtime/debug"
 )
 
@@ -68,6 +68,8 @@ func (self Result[T, E]) ValueOr(defaultValue T) T {
 
 // ValueOrPanic if not an Ok result.
 //
+// Panics with a *PanicError[E] that can be turned back into an Err result by Recover().
+//
 // It is recommended to only call this during app initialization.
 // Otherwise, use Result.ValueOr().
 func (self Result[T, E]) ValueOrPanic() T {
@@ -75,7 +77,10 @@ func (self Result[T, E]) ValueOrPanic() T {
 		return self.value
 	}
 
-	panic(fmt.Sprintf("result panic: %s\nstack trace:\n%s\n", self.err, string(debug.Stack())))
+	panic(&PanicError[E]{
+		Err:   self.err,
+		Stack: debug.Stack(),
+	})
 }
 
 // Result decomposes into the basic (T, error) return value.
@@ -84,3 +89,21 @@ func (self Result[T, E]) ValueOrPanic() T {
 func (self Result[T, E]) Result() (T, E) {
 	return self.value, self.err
 }
+
+// LogValue of the Result.
+//
+// Satisfies slog.LogValuer.
+//
+// An Ok result is logged as the string "Ok" without allocating. The value is never logged.
+// Otherwise, the error is logged using its slog.LogValuer, if implemented, or its Error() string.
+func (self Result[T, E]) LogValue() slog.Value {
+	if self.IsOk() {
+		return slog.StringValue("Ok")
+	}
+
+	if valuer, ok := any(self.err).(slog.LogValuer); ok {
+		return valuer.LogValue()
+	}
+
+	return slog.StringValue(self.err.Error())
+}
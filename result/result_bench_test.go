@@ -4,6 +4,7 @@ import (
 	goerrors "errors"
 	"fmt"
 	"testing"
+	"unsafe"
 
 	"github.com/wspowell/errors"
 	"github.com/wspowell/errors/result"
@@ -174,3 +175,34 @@ func errorErr3() (int, error) {
 
 	return value, nil
 }
+
+type Uint8Error uint8
+
+type Uint32Error uint32
+
+// benchmarkWidth collects a batch of results to compare Causer widths.
+func benchmarkWidth[C errors.Causer](b *testing.B) {
+	results := make([]result.Result[uint32, errors.Error[C]], 4096)
+	for index := range results {
+		results[index] = result.Ok[uint32, errors.Error[C]](uint32(index))
+	}
+
+	size := unsafe.Sizeof(results[0])
+	b.SetBytes(int64(size) * int64(len(results)))
+	b.ReportMetric(float64(size), "B/result")
+
+	var res int
+	for i := 0; i < b.N; i++ {
+		res = len(result.All(results).Value())
+	}
+
+	b.StopTimer()
+
+	resGLOBAL = res
+}
+
+func BenchmarkResultWidth(b *testing.B) {
+	b.Run("uint8", benchmarkWidth[Uint8Error])
+	b.Run("uint32", benchmarkWidth[Uint32Error])
+	b.Run("uint", benchmarkWidth[TestError])
+}
@@ -0,0 +1,54 @@
+package result_test
+
+import (
+	"bytes"
+	goerrors "errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+func logJSON(attrs ...any) string {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			if len(groups) == 0 && (attr.Key == slog.TimeKey || attr.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+
+			return attr
+		},
+	}))
+	logger.Info("test", attrs...)
+
+	return buf.String()
+}
+
+//nolint:paralleltest // reason: AllocsPerRun cannot be run in parallel
+func TestLogValueOk(t *testing.T) {
+	res := result.Ok[int, errors.Error[TestError]](1)
+	assert.JSONEq(t, `{"msg":"test","result":"Ok"}`, logJSON("result", res))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		res.LogValue()
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestLogValueErr(t *testing.T) {
+	t.Parallel()
+
+	res := result.Err[int](errors.New(TestErrorTwo))
+	assert.JSONEq(t, `{"msg":"test","result":{"type":"result_test.TestError","code":2,"name":"result_test.TestError(2)"}}`, logJSON("result", res))
+}
+
+func TestLogValueGoErr(t *testing.T) {
+	t.Parallel()
+
+	res := result.Err[int](goerrors.New("failure"))
+	assert.JSONEq(t, `{"msg":"test","result":"failure"}`, logJSON("result", res))
+}
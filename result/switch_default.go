@@ -0,0 +1,8 @@
+//go:build !errorsdebug
+
+package result
+
+// verifySwitch checks that Switch() has a Case for every Cause.
+//
+// Only enabled by the errorsdebug build tag.
+const verifySwitch = false
@@ -0,0 +1,6 @@
+//go:build errorsdebug
+
+package result
+
+// verifySwitch checks that Switch() has a Case for every Cause.
+const verifySwitch = true
@@ -1,21 +1,75 @@
 package result
 
-// import "context"
+import "context"
 
-// func Then[T any, S any, E Optional](ctx context.Context, self Result[T, E], f func(context.Context, T) Result[S, E]) Result[S, E] {
-// 	if self.IsOk() {
-// 		return f(ctx, self.value)
-// 	}
+// Then calls fn with the value of an Ok result, otherwise the error is passed through.
+func Then[T any, S any, E Error](ctx context.Context, self Result[T, E], fn func(context.Context, T) Result[S, E]) Result[S, E] {
+	if self.IsOk() {
+		return fn(ctx, self.value)
+	}
 
-// 	return Err[S](self.err)
-// }
+	return Err[S](self.err)
+}
 
-// type When[T any, S any, E Optional] Result[T, E]
+// AndThen calls fn with the value of an Ok result, otherwise the error is passed through.
+//
+// Same as Then(), but without a context.
+func AndThen[T any, S any, E Error](self Result[T, E], fn func(T) Result[S, E]) Result[S, E] {
+	if self.IsOk() {
+		return fn(self.value)
+	}
 
-// func (self When[T, S, E]) Then(ctx context.Context, fn func(context.Context, T) Result[S, E]) Result[S, E] {
-// 	if Result[T, E](self).IsOk() {
-// 		return fn(ctx, self.value)
-// 	}
+	return Err[S](self.err)
+}
 
-// 	return Err[S](self.err)
-// }
+// Map the value of an Ok result, otherwise the error is passed through.
+func Map[T any, S any, E Error](self Result[T, E], fn func(T) S) Result[S, E] {
+	if self.IsOk() {
+		return Ok[S, E](fn(self.value))
+	}
+
+	return Err[S](self.err)
+}
+
+// MapErr maps the error of an Err result, otherwise the value is passed through.
+func MapErr[T any, E Error, F Error](self Result[T, E], fn func(E) F) Result[T, F] {
+	if self.IsOk() {
+		return Ok[T, F](self.value)
+	}
+
+	return Err[T](fn(self.err))
+}
+
+// OrElse calls fn with the error of an Err result, otherwise the value is passed through.
+//
+// Used to recover from an error or replace it with another.
+func OrElse[T any, E Error, F Error](self Result[T, E], fn func(E) Result[T, F]) Result[T, F] {
+	if self.IsOk() {
+		return Ok[T, F](self.value)
+	}
+
+	return fn(self.err)
+}
+
+// Flatten a nested result into a single result.
+func Flatten[T any, E Error](self Result[Result[T, E], E]) Result[T, E] {
+	if self.IsOk() {
+		return self.value
+	}
+
+	return Err[T](self.err)
+}
+
+// When allows chaining into a result of a different value type.
+//
+// Go does not allow type parameters on methods, so the next value type S is declared up front:
+//
+//	result.When[int, string](res).Then(ctx, fn)
+type When[T any, S any, E Error] Result[T, E]
+
+// Then calls fn with the value of an Ok result, otherwise the error is passed through.
+//
+// See: Then()
+func (self When[T, S, E]) Then(ctx context.Context, fn func(context.Context, T) Result[S, E]) Result[S, E] {
+	return Then(ctx, Result[T, E](self), fn)
+}
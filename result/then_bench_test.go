@@ -0,0 +1,193 @@
+package result_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
+
+func BenchmarkThenOk(b *testing.B) {
+	ctx := context.Background()
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = result.Then(ctx, resultOk(), addOne)
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkThenErr(b *testing.B) {
+	ctx := context.Background()
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = result.Then(ctx, resultErr(), addOne)
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkManualThenOk(b *testing.B) {
+	ctx := context.Background()
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = resultOk()
+		if res.IsOk() {
+			res = addOne(ctx, res.Value())
+		}
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkMapOk(b *testing.B) {
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = result.Map(resultOk(), func(value int) int { return value + 1 })
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkAndThenChainOk(b *testing.B) {
+	var res result.Result[int, errors.Error[TestError]]
+
+	next := func(value int) result.Result[int, errors.Error[TestError]] {
+		return result.Ok[int, errors.Error[TestError]](value + 1)
+	}
+
+	for i := 0; i < b.N; i++ {
+		res = result.AndThen(result.AndThen(result.AndThen(resultOk(), next), next), next)
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkAndThenChainErr(b *testing.B) {
+	var res result.Result[int, errors.Error[TestError]]
+
+	next := func(value int) result.Result[int, errors.Error[TestError]] {
+		return result.Ok[int, errors.Error[TestError]](value + 1)
+	}
+
+	for i := 0; i < b.N; i++ {
+		res = result.AndThen(result.AndThen(result.AndThen(resultErr(), next), next), next)
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkMapErrOk(b *testing.B) {
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = result.MapErr(resultOk(), func(errors.Error[TestError]) errors.Error[TestError] { return errors.New(TestErrorOne) })
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkMapErrErr(b *testing.B) {
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = result.MapErr(resultErr(), func(errors.Error[TestError]) errors.Error[TestError] { return errors.New(TestErrorOne) })
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkOrElseOk(b *testing.B) {
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = result.OrElse(resultOk(), func(errors.Error[TestError]) result.Result[int, errors.Error[TestError]] { return resultOk() })
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkOrElseErr(b *testing.B) {
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = result.OrElse(resultErr(), func(errors.Error[TestError]) result.Result[int, errors.Error[TestError]] { return resultOk() })
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkFlattenOk(b *testing.B) {
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = result.Flatten(result.Ok[result.Result[int, errors.Error[TestError]], errors.Error[TestError]](resultOk()))
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkFlattenErr(b *testing.B) {
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = result.Flatten(result.Err[result.Result[int, errors.Error[TestError]]](errors.New(TestErrorOne)))
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkWhenThenOk(b *testing.B) {
+	ctx := context.Background()
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = result.When[int, int, errors.Error[TestError]](resultOk()).Then(ctx, addOne)
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
+
+func BenchmarkWhenThenErr(b *testing.B) {
+	ctx := context.Background()
+	var res result.Result[int, errors.Error[TestError]]
+
+	for i := 0; i < b.N; i++ {
+		res = result.When[int, int, errors.Error[TestError]](resultErr()).Then(ctx, addOne)
+	}
+
+	b.StopTimer()
+
+	resGLOBAL, errorGLOBAL = res.Result()
+}
@@ -1,74 +1,154 @@
 package result_test
 
-// import (
-// 	"context"
-// 	"testing"
+import (
+	"context"
+	"strconv"
+	"testing"
 
-// 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/assert"
 
-// 	"github.com/wspowell/errors"
-// 	"github.com/wspowell/errors/result"
-// )
+	"github.com/wspowell/errors"
+	"github.com/wspowell/errors/result"
+)
 
-// func TestThenOk(t *testing.T) {
-// 	t.Parallel()
+type OtherError uint
 
-// 	ctx := context.Background()
+const (
+	OtherErrorOne = OtherError(iota + 1)
+)
 
-// 	value := 1
-// 	res := result.Ok[int, Error](value)
+func addOne(_ context.Context, value int) result.Result[int, errors.Error[TestError]] {
+	return result.Ok[int, errors.Error[TestError]](value + 1)
+}
 
-// 	res2 := result.Then(ctx, res, func(ctx context.Context, v int) result.Result[int, Error] {
-// 		return result.Ok[int, Error](v + 1)
-// 	})
+func TestThenOk(t *testing.T) {
+	t.Parallel()
 
-// 	assert.True(t, res2.IsOk())
-// 	assert.Equal(t, 2, res2.ValueOr(0))
-// }
+	res := result.Ok[int, errors.Error[TestError]](1)
+	res2 := result.Then(context.Background(), res, addOne)
 
-// func TestThenErr(t *testing.T) {
-// 	t.Parallel()
+	assert.True(t, res2.IsOk())
+	assert.Equal(t, 2, res2.ValueOr(0))
+}
 
-// 	ctx := context.Background()
+func TestThenErr(t *testing.T) {
+	t.Parallel()
 
-// 	res := result.Err[int](errors.New(context.Background(), errErrorFailure))
+	res := result.Err[int](errors.New(TestErrorOne))
+	res2 := result.Then(context.Background(), res, addOne)
 
-// 	res2 := result.Then(ctx, res, func(ctx context.Context, v int) result.Result[int, Error] {
-// 		return result.Ok[int, Error](v + 1)
-// 	})
+	assert.False(t, res2.IsOk())
+	assert.Equal(t, 0, res2.ValueOr(0))
+	assert.Equal(t, errors.New(TestErrorOne), res2.Error())
+}
 
-// 	assert.False(t, res2.IsOk())
-// 	assert.Equal(t, 0, res2.ValueOr(0))
-// }
+func TestAndThen(t *testing.T) {
+	t.Parallel()
 
-// func TestWhenThenOk(t *testing.T) {
-// 	t.Parallel()
+	parse := func(value string) result.Result[int, errors.Error[TestError]] {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return result.Err[int](errors.New(TestErrorTwo))
+		}
 
-// 	ctx := context.Background()
+		return result.Ok[int, errors.Error[TestError]](parsed)
+	}
 
-// 	value := 1
-// 	res := result.Ok[int, Error](value)
+	res := result.AndThen(result.Ok[string, errors.Error[TestError]]("1"), parse)
+	assert.Equal(t, 1, res.ValueOr(0))
 
-// 	res2 := result.When[int, float64, Error](res).Then(ctx, func(ctx context.Context, v int) result.Result[float64, Error] {
-// 		return result.Ok[float64, Error](float64(v + 1.0))
-// 	})
+	res = result.AndThen(result.Ok[string, errors.Error[TestError]]("a"), parse)
+	assert.Equal(t, errors.New(TestErrorTwo), res.Error())
 
-// 	assert.True(t, res2.IsOk())
-// 	assert.Equal(t, float64(2), res2.ValueOr(0))
-// }
+	res = result.AndThen(result.Err[string](errors.New(TestErrorOne)), parse)
+	assert.Equal(t, errors.New(TestErrorOne), res.Error())
+}
 
-// func TestWhenThenErr(t *testing.T) {
-// 	t.Parallel()
+func TestMap(t *testing.T) {
+	t.Parallel()
 
-// 	ctx := context.Background()
+	res := result.Map(result.Ok[int, errors.Error[TestError]](1), strconv.Itoa)
+	assert.True(t, res.IsOk())
+	assert.Equal(t, "1", res.ValueOr(""))
 
-// 	res := result.Err[int](errors.New(context.Background(), errErrorFailure))
+	res = result.Map(result.Err[int](errors.New(TestErrorOne)), strconv.Itoa)
+	assert.False(t, res.IsOk())
+	assert.Equal(t, errors.New(TestErrorOne), res.Error())
+}
 
-// 	res2 := result.When[int, float64, Error](res).Then(ctx, func(ctx context.Context, v int) result.Result[float64, Error] {
-// 		return result.Ok[float64, Error](float64(v + 1.0))
-// 	})
+func TestMapErr(t *testing.T) {
+	t.Parallel()
 
-// 	assert.False(t, res2.IsOk())
-// 	assert.Equal(t, float64(0), res2.ValueOr(0))
-// 	assert.Equal(t, errErrorFailure, res.Error().Into())
-// }
+	toOther := func(err errors.Error[TestError]) errors.Error[OtherError] {
+		return errors.New(OtherErrorOne)
+	}
+
+	res := result.MapErr(result.Ok[int, errors.Error[TestError]](1), toOther)
+	assert.True(t, res.IsOk())
+	assert.Equal(t, 1, res.ValueOr(0))
+
+	res = result.MapErr(result.Err[int](errors.New(TestErrorOne)), toOther)
+	assert.False(t, res.IsOk())
+	assert.Equal(t, errors.New(OtherErrorOne), res.Error())
+}
+
+func TestOrElse(t *testing.T) {
+	t.Parallel()
+
+	fallback := func(err errors.Error[TestError]) result.Result[int, errors.Error[OtherError]] {
+		if err.Cause == TestErrorOne {
+			return result.Ok[int, errors.Error[OtherError]](-1)
+		}
+
+		return result.Err[int](errors.New(OtherErrorOne))
+	}
+
+	res := result.OrElse(result.Ok[int, errors.Error[TestError]](1), fallback)
+	assert.Equal(t, 1, res.ValueOr(0))
+
+	res = result.OrElse(result.Err[int](errors.New(TestErrorOne)), fallback)
+	assert.Equal(t, -1, res.ValueOr(0))
+
+	res = result.OrElse(result.Err[int](errors.New(TestErrorTwo)), fallback)
+	assert.Equal(t, errors.New(OtherErrorOne), res.Error())
+}
+
+func TestFlatten(t *testing.T) {
+	t.Parallel()
+
+	nested := result.Ok[result.Result[int, errors.Error[TestError]], errors.Error[TestError]](result.Ok[int, errors.Error[TestError]](1))
+	assert.Equal(t, 1, result.Flatten(nested).ValueOr(0))
+
+	nested = result.Ok[result.Result[int, errors.Error[TestError]], errors.Error[TestError]](result.Err[int](errors.New(TestErrorTwo)))
+	assert.Equal(t, errors.New(TestErrorTwo), result.Flatten(nested).Error())
+
+	nested = result.Err[result.Result[int, errors.Error[TestError]]](errors.New(TestErrorOne))
+	assert.Equal(t, errors.New(TestErrorOne), result.Flatten(nested).Error())
+}
+
+func TestWhenThenOk(t *testing.T) {
+	t.Parallel()
+
+	res := result.Ok[int, errors.Error[TestError]](1)
+
+	res2 := result.When[int, float64, errors.Error[TestError]](res).Then(context.Background(), func(ctx context.Context, v int) result.Result[float64, errors.Error[TestError]] {
+		return result.Ok[float64, errors.Error[TestError]](float64(v) + 1.0)
+	})
+
+	assert.True(t, res2.IsOk())
+	assert.Equal(t, float64(2), res2.ValueOr(0))
+}
+
+func TestWhenThenErr(t *testing.T) {
+	t.Parallel()
+
+	res := result.Err[int](errors.New(TestErrorOne))
+
+	res2 := result.When[int, float64, errors.Error[TestError]](res).Then(context.Background(), func(ctx context.Context, v int) result.Result[float64, errors.Error[TestError]] {
+		return result.Ok[float64, errors.Error[TestError]](float64(v) + 1.0)
+	})
+
+	assert.False(t, res2.IsOk())
+	assert.Equal(t, float64(0), res2.ValueOr(0))
+	assert.Equal(t, errors.New(TestErrorOne), res2.Error())
+}
@@ -0,0 +1,166 @@
+package errors
+
+import (
+	"context"
+	goerrors "errors"
+	"log/slog"
+	"reflect"
+)
+
+// LogValue of the Error.
+//
+// Satisfies slog.LogValuer.
+//
+// Ok is logged as the string "Ok" without allocating.
+// Otherwise, the Error is logged as a group of the Cause type, numeric code, and name
+// along with any registered CauseInfo.
+func (self Error[T]) LogValue() slog.Value {
+	if self.IsOk() {
+		return slog.StringValue("Ok")
+	}
+
+	attrs := []slog.Attr{
+		slog.String("type", reflect.TypeFor[T]().String()),
+		slog.Uint64("code", uint64(self.Cause)),
+		slog.String("name", self.Error()),
+	}
+
+	if info, ok := self.Info(); ok {
+		if info.Code != "" {
+			attrs = append(attrs, slog.String("wire_code", info.Code))
+		}
+
+		attrs = append(attrs,
+			slog.String("severity", info.Severity.String()),
+			slog.Bool("retryable", info.Retryable),
+			slog.Bool("public", info.Public),
+		)
+
+		if info.DocsURL != "" {
+			attrs = append(attrs, slog.String("docs", info.DocsURL))
+		}
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// LogHandler wraps a slog.Handler and expands errors that wrap a slog.LogValuer.
+//
+// A golang error is normally logged as its Error() string. Any error attribute whose chain
+// contains a slog.LogValuer (such as an Error[T]) is expanded into a group of the message
+// and the resolved value of the slog.LogValuer.
+type LogHandler struct {
+	handler slog.Handler
+}
+
+// NewLogHandler wrapping the given handler.
+func NewLogHandler(handler slog.Handler) *LogHandler {
+	return &LogHandler{
+		handler: handler,
+	}
+}
+
+// Enabled reports whether the wrapped handler handles records at the given level.
+func (self *LogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return self.handler.Enabled(ctx, level)
+}
+
+// Handle the record after expanding error attributes.
+func (self *LogHandler) Handle(ctx context.Context, record slog.Record) error {
+	expand := false
+	record.Attrs(func(attr slog.Attr) bool {
+		expand = needsExpand(attr.Value)
+
+		return !expand
+	})
+
+	if !expand {
+		//nolint:wrapcheck // reason: pass through to the wrapped handler
+		return self.handler.Handle(ctx, record)
+	}
+
+	expanded := slog.NewRecord(record.Time, record.Level, record.Message, record.PC)
+	record.Attrs(func(attr slog.Attr) bool {
+		expanded.AddAttrs(expandAttr(attr))
+
+		return true
+	})
+
+	//nolint:wrapcheck // reason: pass through to the wrapped handler
+	return self.handler.Handle(ctx, expanded)
+}
+
+// WithAttrs returns a new LogHandler whose attributes are expanded.
+func (self *LogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	expanded := make([]slog.Attr, len(attrs))
+	for index, attr := range attrs {
+		expanded[index] = expandAttr(attr)
+	}
+
+	return NewLogHandler(self.handler.WithAttrs(expanded))
+}
+
+// WithGroup returns a new LogHandler with the given group.
+func (self *LogHandler) WithGroup(name string) slog.Handler {
+	return NewLogHandler(self.handler.WithGroup(name))
+}
+
+func needsExpand(value slog.Value) bool {
+	switch value.Kind() {
+	case slog.KindGroup:
+		for _, attr := range value.Group() {
+			if needsExpand(attr.Value) {
+				return true
+			}
+		}
+	case slog.KindAny:
+		_, ok := expandableError(value)
+
+		return ok
+	default:
+	}
+
+	return false
+}
+
+func expandAttr(attr slog.Attr) slog.Attr {
+	switch attr.Value.Kind() {
+	case slog.KindGroup:
+		group := attr.Value.Group()
+		expanded := make([]slog.Attr, len(group))
+		for index, each := range group {
+			expanded[index] = expandAttr(each)
+		}
+
+		return slog.Attr{Key: attr.Key, Value: slog.GroupValue(expanded...)}
+	case slog.KindAny:
+		if err, ok := expandableError(attr.Value); ok {
+			var valuer slog.LogValuer
+			goerrors.As(err, &valuer)
+
+			return slog.Group(attr.Key,
+				slog.String("msg", err.Error()),
+				slog.Attr{Key: "cause", Value: valuer.LogValue()},
+			)
+		}
+	default:
+	}
+
+	return attr
+}
+
+// expandableError returns the error if it is not itself a slog.LogValuer but wraps one.
+func expandableError(value slog.Value) (error, bool) {
+	err, ok := value.Any().(error)
+	if !ok {
+		return nil, false
+	}
+
+	if _, ok := err.(slog.LogValuer); ok {
+		return nil, false
+	}
+
+	var valuer slog.LogValuer
+
+	return err, goerrors.As(err, &valuer)
+}
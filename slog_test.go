@@ -0,0 +1,82 @@
+package errors_test
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+)
+
+func newTestLogger(buf *bytes.Buffer, wrap bool) *slog.Logger {
+	var handler slog.Handler = slog.NewJSONHandler(buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			if len(groups) == 0 && (attr.Key == slog.TimeKey || attr.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+
+			return attr
+		},
+	})
+
+	if wrap {
+		handler = errors.NewLogHandler(handler)
+	}
+
+	return slog.New(handler)
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	newTestLogger(&buf, false).Info("test", "err", errors.New(TestErrorMyBad))
+	assert.JSONEq(t, `{"msg":"test","err":{"type":"errors_test.TestError","code":1,"name":"MyBad"}}`, buf.String())
+}
+
+func TestLogValueOk(t *testing.T) {
+	var buf bytes.Buffer
+	newTestLogger(&buf, false).Info("test", "err", errors.Ok[TestError]())
+	assert.JSONEq(t, `{"msg":"test","err":"Ok"}`, buf.String())
+
+	err := errors.Ok[TestError]()
+	allocs := testing.AllocsPerRun(100, func() {
+		err.LogValue()
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestLogHandlerExpandsWrappedError(t *testing.T) {
+	wrapped := fmt.Errorf("wrapped: %w", errors.New(TestErrorInternalFailure))
+
+	var buf bytes.Buffer
+	newTestLogger(&buf, false).Info("test", "err", wrapped)
+	assert.JSONEq(t, `{"msg":"test","err":"wrapped: InternalFailure"}`, buf.String())
+
+	buf.Reset()
+	newTestLogger(&buf, true).Info("test", "err", wrapped, slog.Group("nested", "err", wrapped))
+	assert.JSONEq(t, `{
+		"msg": "test",
+		"err": {"msg": "wrapped: InternalFailure", "cause": {"type": "errors_test.TestError", "code": 2, "name": "InternalFailure"}},
+		"nested": {"err": {"msg": "wrapped: InternalFailure", "cause": {"type": "errors_test.TestError", "code": 2, "name": "InternalFailure"}}}
+	}`, buf.String())
+}
+
+func TestLogHandlerWithAttrs(t *testing.T) {
+	wrapped := fmt.Errorf("wrapped: %w", errors.New(TestErrorMyBad))
+
+	var buf bytes.Buffer
+	newTestLogger(&buf, true).With("err", wrapped).WithGroup("group").Info("test", "other", 1)
+	assert.JSONEq(t, `{
+		"msg": "test",
+		"err": {"msg": "wrapped: MyBad", "cause": {"type": "errors_test.TestError", "code": 1, "name": "MyBad"}},
+		"group": {"other": 1}
+	}`, buf.String())
+}
+
+func TestLogHandlerPassThrough(t *testing.T) {
+	var buf bytes.Buffer
+	newTestLogger(&buf, true).Info("test", "err", errors.New(TestErrorMyBad), "other", 1)
+	assert.JSONEq(t, `{"msg":"test","err":{"type":"errors_test.TestError","code":1,"name":"MyBad"},"other":1}`, buf.String())
+}
@@ -0,0 +1,53 @@
+package errors
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"strconv"
+)
+
+// StackTrace of program counters where an Error was created.
+//
+// Frames are only symbolized when formatted.
+type StackTrace []uintptr
+
+// Frames of the stack trace.
+func (self StackTrace) Frames() *runtime.Frames {
+	return runtime.CallersFrames(self)
+}
+
+// Format the stack trace.
+//
+// Satisfies fmt.Formatter.
+//
+// %+v writes each frame as its function followed by its file and line on the next line.
+// Otherwise, each frame is written as its function.
+func (self StackTrace) Format(state fmt.State, verb rune) {
+	frames := self.Frames()
+
+	for {
+		frame, more := frames.Next()
+		if frame.Function == "" && !more {
+			return
+		}
+
+		io.WriteString(state, frame.Function) //nolint:errcheck // reason: fmt.State writes do not fail
+		if verb == 'v' && state.Flag('+') {
+			io.WriteString(state, "\n\t"+frame.File+":"+strconv.Itoa(frame.Line)) //nolint:errcheck // reason: fmt.State writes do not fail
+		}
+
+		if !more {
+			return
+		}
+
+		io.WriteString(state, "\n") //nolint:errcheck // reason: fmt.State writes do not fail
+	}
+}
+
+// StackTrace where the Error was created.
+//
+// Only recorded when built with the errorsdebug build tag. Otherwise, this is always nil.
+func (self Error[T]) StackTrace() StackTrace {
+	return self.trace()
+}
@@ -0,0 +1,12 @@
+//go:build !errorsdebug
+
+package errors
+
+// stack is zero size unless built with the errorsdebug build tag.
+type stack struct{}
+
+func (stack) record() {}
+
+func (stack) trace() StackTrace {
+	return nil
+}
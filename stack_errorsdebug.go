@@ -0,0 +1,31 @@
+//go:build errorsdebug
+
+package errors
+
+import (
+	"runtime"
+)
+
+// maxStackDepth of a recorded stack trace.
+const maxStackDepth = 32
+
+// stack of program counters stored inline so that recording does not allocate.
+type stack struct {
+	pcs   [maxStackDepth]uintptr
+	depth uint8
+}
+
+// record the stack of the caller of New().
+func (self *stack) record() {
+	// Skip runtime.Callers(), record(), and New().
+	//nolint:gomnd // reason: number of frames to skip
+	self.depth = uint8(runtime.Callers(3, self.pcs[:]))
+}
+
+func (self stack) trace() StackTrace {
+	if self.depth == 0 {
+		return nil
+	}
+
+	return StackTrace(self.pcs[:self.depth])
+}
@@ -0,0 +1,48 @@
+//go:build errorsdebug
+
+package errors_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wspowell/errors"
+)
+
+func newStackError() errors.Error[TestError] {
+	return errors.New(TestErrorMyBad)
+}
+
+func TestStackTraceEnabled(t *testing.T) {
+	err := newStackError()
+
+	trace := err.StackTrace()
+	require.NotEmpty(t, trace)
+
+	frame, _ := trace.Frames().Next()
+	assert.Equal(t, "github.com/wspowell/errors_test.newStackError", frame.Function)
+	assert.True(t, strings.HasSuffix(frame.File, "stack_errorsdebug_test.go"))
+
+	formatted := fmt.Sprintf("%+v", err)
+	assert.True(t, strings.HasPrefix(formatted, "MyBad (errors_test.TestError=1)\ngithub.com/wspowell/errors_test.newStackError\n\t"))
+	assert.Contains(t, formatted, "stack_errorsdebug_test.go:")
+
+	assert.Equal(t, "MyBad", fmt.Sprintf("%v", err))
+	assert.Equal(t, err.Cause, newStackError().Cause)
+	assert.Nil(t, errors.Ok[TestError]().StackTrace())
+}
+
+func TestStackTraceAllocations(t *testing.T) {
+	var err errors.Error[TestError]
+
+	allocs := testing.AllocsPerRun(100, func() {
+		err = newStackError()
+	})
+
+	assert.Zero(t, allocs)
+	assert.True(t, err.IsErr())
+}
@@ -0,0 +1,21 @@
+//go:build !errorsdebug
+
+package errors_test
+
+import (
+	"fmt"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wspowell/errors"
+)
+
+func TestStackTraceDisabled(t *testing.T) {
+	err := errors.New(TestErrorMyBad)
+	assert.Nil(t, err.StackTrace())
+	assert.Equal(t, "MyBad (errors_test.TestError=1)", fmt.Sprintf("%+v", err))
+	assert.Equal(t, unsafe.Sizeof(TestError(0)), unsafe.Sizeof(err))
+	assert.Equal(t, errors.New(TestErrorMyBad), err)
+}